@@ -17,7 +17,7 @@
 # Options:
 #   --prefix PREFIX        Resource name prefix to search for (default: CS_CLUSTER_NAME env var, else CAPI_USER-DEPLOYMENT_ENV, else cate)
 #                          Note: The Go test suite auto-generates CS_CLUSTER_NAME as CAPI_USER-<random>; this fallback is for standalone script usage.
-#   --resource-group RG    Also delete this Azure resource group
+#   --resource-group RG    Also delete this Azure resource group (repeat for several groups)
 #   --match-mode MODE      How to match resource names: 'startswith' (default, safer) or 'contains' (broader)
 #   --my-resources         Find all resources tagged with capi-test-user=$CAPI_USER (or $USER fallback) (dry-run)
 #   --tag KEY=VALUE        Find resources by Azure tag (e.g., 'capi-test-user=alice')
@@ -36,6 +36,7 @@
 #   ./scripts/cleanup-azure-resources.sh --dry-run
 #   ./scripts/cleanup-azure-resources.sh --prefix cate-stage --force
 #   ./scripts/cleanup-azure-resources.sh --resource-group myapp-resgroup --prefix myapp
+#   ./scripts/cleanup-azure-resources.sh --resource-group app1-resgroup --resource-group app2-resgroup --prefix app
 #   CS_CLUSTER_NAME=cate-stage ./scripts/cleanup-azure-resources.sh
 #   ./scripts/cleanup-azure-resources.sh --prefix cate --match-mode contains  # broader search
 #   ./scripts/cleanup-azure-resources.sh --my-resources                       # find all my test resources
@@ -53,7 +54,7 @@ elif [[ -n "${CAPI_USER:-}" ]]; then
 else
     PREFIX="cate"
 fi
-RESOURCE_GROUP=""
+RESOURCE_GROUPS=()
 MATCH_MODE="startswith"
 TAG_FILTER=""
 DRY_RUN=false
@@ -94,7 +95,7 @@ while [[ $# -gt 0 ]]; do
                 print_error "Missing value for --resource-group"
                 exit 1
             fi
-            RESOURCE_GROUP="$2"
+            RESOURCE_GROUPS+=("$2")
             shift 2
             ;;
         --match-mode)
@@ -824,9 +825,10 @@ main() {
         print_info "Resource prefix: ${PREFIX}"
         print_info "Match mode: ${MATCH_MODE}"
     fi
-    if [[ -n "$RESOURCE_GROUP" ]]; then
-        print_info "Resource group: ${RESOURCE_GROUP}"
-    fi
+    local rg
+    for rg in ${RESOURCE_GROUPS[@]+"${RESOURCE_GROUPS[@]}"}; do
+        print_info "Resource group: ${rg}"
+    done
     if [[ "$DRY_RUN" == "true" ]]; then
         print_warning "DRY-RUN mode enabled - no resources will be deleted"
     fi
@@ -956,11 +958,14 @@ main() {
     # Step 2: Purge all soft-deleted KVs (from step 1 + leftovers from older runs).
     # This must happen before RG deletion — otherwise deleting the RG soft-deletes
     # its KVs and Azure rejects new KVs with the same name.
-    if [[ -n "$RESOURCE_GROUP" ]] && az group show --name "$RESOURCE_GROUP" >/dev/null 2>&1; then
+    for rg in ${RESOURCE_GROUPS[@]+"${RESOURCE_GROUPS[@]}"}; do
+        if ! az group show --name "$rg" >/dev/null 2>&1; then
+            continue
+        fi
         echo ""
-        print_info "Searching for active Key Vaults in '${RESOURCE_GROUP}' with prefix '${PREFIX}'..."
+        print_info "Searching for active Key Vaults in '${rg}' with prefix '${PREFIX}'..."
         local active_kvs
-        active_kvs=$(az keyvault list --resource-group "$RESOURCE_GROUP" \
+        active_kvs=$(az keyvault list --resource-group "$rg" \
             --query "[?starts_with(name, '${PREFIX}')].{name: name, location: location}" -o json 2>/dev/null || echo "[]")
         local active_count
         active_count=$(echo "$active_kvs" | jq -r 'length // 0')
@@ -990,9 +995,9 @@ main() {
                 fi
             done < <(echo "$active_kvs" | jq -r '.[] | "\(.name)|\(.location)"')
         else
-            print_info "No active Key Vaults found in '${RESOURCE_GROUP}'"
+            print_info "No active Key Vaults found in '${rg}'"
         fi
-    fi
+    done
 
     echo ""
     local vaults_json
@@ -1004,12 +1009,12 @@ main() {
     fi
 
     # Delete resource group (KVs already purged — nothing left to soft-delete)
-    if [[ -n "$RESOURCE_GROUP" ]]; then
-        if az group show --name "$RESOURCE_GROUP" >/dev/null 2>&1; then
+    for rg in ${RESOURCE_GROUPS[@]+"${RESOURCE_GROUPS[@]}"}; do
+        if az group show --name "$rg" >/dev/null 2>&1; then
             found_any=true
         fi
-        delete_resource_group "$RESOURCE_GROUP"
-    fi
+        delete_resource_group "$rg"
+    done
 
     # Find and cleanup ARM resources
     local resources_json
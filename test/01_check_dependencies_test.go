@@ -11,8 +11,11 @@ import (
 	"testing"
 )
 
-// TestCheckDependencies_ToolAvailable verifies all required tools are installed
+// TestCheckDependencies_ToolAvailable verifies the required tools are installed: those of every
+// phase, or only of the phases listed in TOOL_PHASES
 func TestCheckDependencies_ToolAvailable(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	// Check if config initialization failed
 	if configError != nil {
 		t.Fatalf("Configuration initialization failed: %s", *configError)
@@ -20,26 +23,40 @@ func TestCheckDependencies_ToolAvailable(t *testing.T) {
 
 	config := NewTestConfig()
 
-	// Common tools required regardless of provider
-	// docker and kind are only needed for Kind mode (local management cluster)
-	// When using an external cluster (USE_KUBECONFIG), they are not required
-	commonTools := []string{
-		"oc",
-		"helm",
-		"git",
-		"kubectl",
-		"go",
-		"xmllint",
-		"envsubst",
+	// Only the tools the selected phases need (TOOL_PHASES), so a partial workflow such as
+	// generate-only does not require docker or kind. Unset checks every phase's tools.
+	requiredTools, err := config.RequiredToolsForPhases(config.ToolPhases...)
+	if err != nil {
+		t.Fatalf("Invalid TOOL_PHASES: %v", err)
 	}
-	if !config.IsExternalCluster() {
-		commonTools = append([]string{"docker", "kind"}, commonTools...)
+	if len(config.ToolPhases) > 0 {
+		t.Logf("Checking tools for phases: %s", strings.Join(config.ToolPhases, ", "))
+	}
+
+	checkTools(t, config, requiredTools)
+}
+
+// CheckToolsForPhase checks the CLI tools one PHASE needs (see PhaseRequiredTools), one
+// sub-test per tool. Each later phase's first test calls it, so a phase run on its own
+// validates just its own dependencies.
+func CheckToolsForPhase(t *testing.T, phase string) {
+	t.Helper()
+
+	config := NewTestConfig()
+	tools, err := config.RequiredToolsForPhases(phase)
+	if err != nil {
+		t.Fatalf("Cannot check tools: %v", err)
 	}
+	checkTools(t, config, tools)
+}
 
-	// Add provider-specific tools (e.g., "az" for ARO, "aws" for ROSA)
-	requiredTools := append(commonTools, config.AllRequiredTools()...)
+// checkTools runs one sub-test per tool, failing each one that is not on PATH with
+// installation instructions, and checks the version of those with a ToolMinVersions entry.
+// podman is accepted in place of docker.
+func checkTools(t *testing.T, config *TestConfig, tools []string) {
+	t.Helper()
 
-	for _, tool := range requiredTools {
+	for _, tool := range tools {
 		t.Run(tool, func(t *testing.T) {
 			if !CommandExists(tool) {
 				// Check alternative for docker (podman)
@@ -49,8 +66,11 @@ func TestCheckDependencies_ToolAvailable(t *testing.T) {
 				}
 				t.Errorf("Required tool '%s' is not installed or not in PATH.\n\n%s",
 					tool, getToolInstallInstructions(tool))
-			} else {
-				t.Logf("Tool '%s' is available", tool)
+				return
+			}
+			t.Logf("Tool '%s' is available", tool)
+			if minVersion, ok := config.ToolMinVersions[tool]; ok {
+				CheckToolVersion(t, tool, minVersion)
 			}
 		})
 	}
@@ -59,6 +79,8 @@ func TestCheckDependencies_ToolAvailable(t *testing.T) {
 // TestCheckDependencies_OptionalTools checks for optional tools that enhance functionality.
 // These tools are not required for basic operation but enable additional features.
 func TestCheckDependencies_OptionalTools(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	optionalTools := []struct {
 		name        string
 		description string
@@ -209,6 +231,8 @@ func TestCheckDependencies_MCEAuthentication(t *testing.T) {
 // TestCheckDependencies_ExternalKubeconfig validates the external kubeconfig when USE_KUBECONFIG is set.
 // This validates connectivity early before other tests fail with confusing errors.
 func TestCheckDependencies_ExternalKubeconfig(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	if !config.IsExternalCluster() {
@@ -240,70 +264,177 @@ func TestCheckDependencies_ExternalKubeconfig(t *testing.T) {
 	t.Logf("External cluster is accessible, found %d node(s)", nodeCount)
 }
 
-// TestCheckDependencies_DockerDaemonRunning verifies the Docker daemon is running and accessible.
-// This catches issues early before Kind Cluster tests fail with confusing errors.
-// On macOS, provides instructions for starting Docker Desktop or Rancher Desktop.
-func TestCheckDependencies_DockerDaemonRunning(t *testing.T) {
-	// Skip in external cluster mode — Docker is only needed for Kind
+// TestCheckDependencies_KubeconfigMerge explains how the suite treats a KUBECONFIG that merges
+// several files. Tests target the management cluster with an explicit --context, never the
+// merged current-context, and with USE_KUBECONFIG they replace KUBECONFIG for the test process,
+// hiding the other files' contexts. Names defined differently in several files are warnings,
+// except on the context the suite targets, where kubectl could silently pick the wrong cluster.
+func TestCheckDependencies_KubeconfigMerge(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	paths := KubeconfigPaths(os.Getenv("KUBECONFIG"))
+	if len(paths) < 2 {
+		t.Skip("KUBECONFIG does not list multiple files, nothing to check")
+	}
+
 	config := NewTestConfig()
+
+	merge, err := AnalyzeKubeconfigMerge(paths)
+	if err != nil {
+		t.Fatalf("Cannot read merged KUBECONFIG: %v\n\n"+
+			"To fix this:\n"+
+			"  1. Fix or remove the file from KUBECONFIG\n"+
+			"  2. Or point KUBECONFIG at a single file for the test run", err)
+	}
+
+	PrintToTTY("\n=== KUBECONFIG merges %d files ===\n", len(paths))
+	for _, path := range merge.Paths {
+		PrintToTTY("  %s\n", path)
+	}
+	for _, path := range merge.Missing {
+		PrintToTTY("  %s (missing, ignored by kubectl)\n", path)
+	}
+	if merge.CurrentContext != "" {
+		PrintToTTY("Merged current-context: %s (from %s)\n", merge.CurrentContext, merge.CurrentFrom)
+	}
+
+	target := config.GetKubeContext()
+	if config.IsExternalCluster() {
+		PrintToTTY("⚠️  USE_KUBECONFIG is set: tests replace KUBECONFIG with %s, so contexts from these files are not visible to them\n", config.UseKubeconfig)
+		t.Logf("Warning: KUBECONFIG lists %d files; tests override it with USE_KUBECONFIG=%s and target context %s explicitly",
+			len(paths), config.UseKubeconfig, target)
+	} else {
+		PrintToTTY("⚠️  Tests target context %s explicitly and ignore the merged current-context; kind writes its context to %s\n", target, paths[0])
+		t.Logf("Warning: KUBECONFIG lists %d files; tests target context %s explicitly, and kind writes it to %s",
+			len(paths), target, paths[0])
+	}
+
+	for _, c := range merge.Conflicts {
+		PrintToTTY("⚠️  %s\n", c)
+		t.Logf("Warning: %s", c)
+	}
+	PrintToTTY("\n")
+
+	// With USE_KUBECONFIG the merged files are not used, so only Kind mode can be affected
 	if config.IsExternalCluster() {
-		t.Skip("Skipping Docker daemon check in external cluster mode (USE_KUBECONFIG is set)")
 		return
 	}
+	if c := merge.Conflict(target); c != nil {
+		t.Errorf("The suite's context %s is ambiguous in KUBECONFIG: %s\n\n"+
+			"To fix this:\n"+
+			"  1. Remove or rename the duplicate entry: kubectl config get-contexts\n"+
+			"  2. Or run with KUBECONFIG pointing at a single file", target, c)
+	}
+}
 
-	// Skip if using podman instead of docker
-	if !CommandExists("docker") {
-		if CommandExists("podman") {
-			t.Skip("Using podman instead of docker, skipping Docker daemon check")
-			return
-		}
-		t.Skip("Docker not installed, skipping daemon check")
+// TestCheckDependencies_ContainerRuntimeRunning verifies the Docker or podman daemon is running
+// and reachable, not just installed. Kind fails cryptically without it, so this catches the
+// problem before Kind Cluster tests run. Docker Desktop not being started and the rootless
+// podman socket being down are detected specifically, with the matching fix.
+func TestCheckDependencies_ContainerRuntimeRunning(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	// Skip in external cluster mode — a container runtime is only needed for Kind
+	config := NewTestConfig()
+	if config.IsExternalCluster() {
+		t.Skip("Skipping container runtime check in external cluster mode (USE_KUBECONFIG is set)")
+		return
+	}
+
+	runtimeName := DetectContainerRuntime()
+	if runtimeName == "" {
+		t.Skip("Neither docker nor podman installed, skipping daemon check")
 		return
 	}
 
 	// Skip in CI environments where Docker may not be available
 	if os.Getenv("CI") == "true" || os.Getenv("GITHUB_ACTIONS") == "true" {
-		t.Skip("Skipping Docker daemon check in CI environment")
+		t.Skip("Skipping container runtime check in CI environment")
 		return
 	}
 
-	// Check if docker daemon is responding
-	output, err := RunCommandQuiet(t, "docker", "info", "--format", "{{.ServerVersion}}")
+	// Check if the daemon is responding
+	output, err := RunCommandQuietWithTimeout(t, ContainerRuntimeInfoTimeout, runtimeName, ContainerRuntimeInfoArgs(runtimeName)...)
 	if err != nil {
-		// Build platform-specific error message
-		var helpMessage string
-		switch runtime.GOOS {
-		case "darwin":
-			helpMessage = "\nTo start Docker on macOS, run one of:\n" +
-				"  open -a 'Rancher Desktop'\n" +
-				"  open -a 'Docker Desktop'\n" +
-				"  open -a Docker\n\n" +
-				"Then wait a few seconds for the daemon to start."
-		case "linux":
-			helpMessage = "\nTo start Docker on Linux, run:\n" +
-				"  sudo systemctl start docker\n\n" +
-				"Or check if the Docker socket exists:\n" +
-				"  ls -la /var/run/docker.sock"
-		default:
-			helpMessage = "\nPlease start your Docker daemon and try again."
-		}
-
-		t.Fatalf("Docker daemon is not running or not accessible.\n%s\n\nError: %v", helpMessage, err)
+		t.Fatalf("%s daemon is not running or not accessible.\n\n%s\n\nError: %v\nOutput: %s",
+			runtimeName, DiagnoseContainerRuntimeError(runtimeName, runtime.GOOS, output), err, output)
 	}
 
 	serverVersion := strings.TrimSpace(output)
 	if serverVersion == "" {
-		t.Log("Docker daemon is running (version unknown)")
+		t.Logf("%s daemon is running (version unknown)", runtimeName)
 	} else {
-		t.Logf("Docker daemon is running, server version: %s", serverVersion)
+		t.Logf("%s daemon is running, server version: %s", runtimeName, serverVersion)
 	}
 }
 
+// TestCheckDependencies_DiskSpace verifies the container runtime data root and the temp
+// directory have at least MIN_FREE_DISK_SPACE free. Kind node images and controller image
+// pulls fail with opaque errors on a full disk, so this fails early with real numbers.
+func TestCheckDependencies_DiskSpace(t *testing.T) {
+	config := NewTestConfig()
+
+	PrintTestHeader(t, "TestCheckDependencies_DiskSpace",
+		fmt.Sprintf("Verify at least %s free for kind and image pulls", FormatBytes(config.MinFreeDiskSpace)))
+
+	t.Run("TempDir", func(t *testing.T) {
+		tempDir := os.TempDir()
+		if err := CheckDiskSpace(t, tempDir, config.MinFreeDiskSpace); err != nil {
+			PrintToTTY("❌ Temp directory: %v\n", err)
+			t.Fatalf("Insufficient disk space in temp directory: %v\n\n"+
+				"To fix this:\n"+
+				"  1. Free space under %s\n"+
+				"  2. Or point TMPDIR at a larger filesystem\n"+
+				"  3. Or lower the threshold: export MIN_FREE_DISK_SPACE=5G", err, tempDir)
+		}
+		PrintToTTY("✅ Temp directory %s has enough free space\n", tempDir)
+	})
+
+	t.Run("ContainerRuntimeDataRoot", func(t *testing.T) {
+		// A container runtime is only needed for Kind
+		if config.IsExternalCluster() {
+			t.Skip("Skipping container runtime disk check in external cluster mode (USE_KUBECONFIG is set)")
+		}
+
+		runtimeName := DetectContainerRuntime()
+		if runtimeName == "" {
+			t.Skip("Neither docker nor podman installed, skipping data root disk check")
+		}
+
+		if os.Getenv("CI") == "true" || os.Getenv("GITHUB_ACTIONS") == "true" {
+			t.Skip("Skipping container runtime disk check in CI environment")
+		}
+
+		dataRoot, err := ContainerRuntimeDataRoot(t, runtimeName)
+		if err != nil {
+			t.Skipf("Cannot determine %s data root (daemon not reachable?): %v", runtimeName, err)
+		}
+
+		// Docker Desktop and podman machine keep the data root inside a VM
+		if _, err := os.Stat(dataRoot); err != nil {
+			PrintToTTY("⏭️  %s data root %s is not on this host (VM-based runtime), skipping\n", runtimeName, dataRoot)
+			t.Skipf("%s data root %s is not accessible from the host: %v", runtimeName, dataRoot, err)
+		}
+
+		if err := CheckDiskSpace(t, dataRoot, config.MinFreeDiskSpace); err != nil {
+			PrintToTTY("❌ %s data root: %v\n", runtimeName, err)
+			t.Fatalf("Insufficient disk space for %s images: %v\n\n"+
+				"To fix this:\n"+
+				"  1. Remove unused images and containers: %s system prune -a\n"+
+				"  2. Or delete stale kind clusters: kind get clusters / kind delete cluster --name <name>\n"+
+				"  3. Or lower the threshold: export MIN_FREE_DISK_SPACE=5G", runtimeName, err, runtimeName)
+		}
+		PrintToTTY("✅ %s data root %s has enough free space\n", runtimeName, dataRoot)
+	})
+}
+
 // TestCheckDependencies_PythonVersion validates Python version compatibility.
 // Python 3.14.0 has known incompatibilities with az cli and will fail fast.
 // Python 3.14.2 is the tested and recommended version.
 // Other versions will show a warning but allow tests to continue.
 func TestCheckDependencies_PythonVersion(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	// Determine which Python command to use
 	var pythonCmd string
 	if CommandExists("python3") {
@@ -398,6 +529,8 @@ func Sscanf(s string, format string, a ...interface{}) (int, error) {
 // they are validated by performing an actual login. If not set, the test falls back to checking
 // Azure CLI login status.
 func TestCheckDependencies_AzureAuthentication(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 	if !config.HasProvider("aro") {
 		t.Skip("Skipping Azure authentication check (provider is not aro)")
@@ -444,6 +577,8 @@ func TestCheckDependencies_AzureAuthentication(t *testing.T) {
 // When using Azure CLI, environment variables are auto-extracted if not set.
 // This provides seamless UX for users who are logged in with Azure CLI.
 func TestCheckDependencies_AzureEnvironment(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 	if !config.HasProvider("aro") {
 		t.Skip("Skipping Azure environment validation (provider is not aro)")
@@ -502,7 +637,7 @@ func TestCheckDependencies_AzureEnvironment(t *testing.T) {
 	// Check AZURE_SUBSCRIPTION_ID or AZURE_SUBSCRIPTION_NAME - try to auto-extract if not set
 	t.Run("AZURE_SUBSCRIPTION", func(t *testing.T) {
 		subscriptionID := os.Getenv("AZURE_SUBSCRIPTION_ID")
-		subscriptionName := os.Getenv("AZURE_SUBSCRIPTION_NAME")
+		subscriptionName := os.Getenv(EnvAzureSubscriptionName)
 
 		if subscriptionID != "" {
 			t.Log("AZURE_SUBSCRIPTION_ID is set via environment variable")
@@ -556,6 +691,8 @@ func TestCheckDependencies_AzureEnvironment(t *testing.T) {
 
 // TestCheckDependencies_OpenShiftCLI_IsAvailable verifies OpenShift CLI is functional
 func TestCheckDependencies_OpenShiftCLI_IsAvailable(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	output, err := RunCommand(t, "oc", "version", "--client")
 	if err != nil {
 		t.Errorf("OpenShift CLI version check failed: %v\n\n"+
@@ -573,6 +710,8 @@ func TestCheckDependencies_OpenShiftCLI_IsAvailable(t *testing.T) {
 
 // TestCheckDependencies_Helm_IsAvailable verifies Helm is installed and functional
 func TestCheckDependencies_Helm_IsAvailable(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	output, err := RunCommand(t, "helm", "version", "--short")
 	if err != nil {
 		t.Errorf("Helm version check failed: %v\n\n"+
@@ -590,6 +729,8 @@ func TestCheckDependencies_Helm_IsAvailable(t *testing.T) {
 
 // TestCheckDependencies_Kind_IsAvailable verifies Kind is installed
 func TestCheckDependencies_Kind_IsAvailable(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	// Skip in external cluster mode — Kind is only needed for local management cluster
 	config := NewTestConfig()
 	if config.IsExternalCluster() {
@@ -620,6 +761,8 @@ func TestCheckDependencies_Kind_IsAvailable(t *testing.T) {
 // Makefile only downloads the linux-amd64 binary. This test fails on Mac when clusterctl
 // is missing to prevent confusing deployment failures later.
 func TestCheckDependencies_Clusterctl_IsAvailable(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	if CommandExists("clusterctl") {
 		output, err := RunCommand(t, "clusterctl", "version")
 		if err != nil {
@@ -702,6 +845,8 @@ func TestCheckDependencies_Clusterctl_IsAvailable(t *testing.T) {
 // is within Azure/ARO limits. This catches configuration errors early (in phase 1)
 // rather than waiting for deployment failures during CR reconciliation.
 func TestCheckDependencies_NamingConstraints(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 	if !config.HasProvider("aro") {
 		t.Skip("Skipping Azure naming constraints (provider is not aro)")
@@ -755,6 +900,8 @@ func TestCheckDependencies_NamingConstraints(t *testing.T) {
 // configured in the Docker config file (credsStore or credHelpers) are available in PATH.
 // Only runs on macOS, where missing credential helpers are a common issue with Docker Desktop alternatives.
 func TestCheckDependencies_DockerCredentialHelper(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	// Only run on macOS where this is a common issue
 	if runtime.GOOS != "darwin" {
 		t.Skip("Skipping Docker credential helper check (not macOS)")
@@ -848,6 +995,8 @@ func TestCheckDependencies_DockerCredentialHelper(t *testing.T) {
 // Failing early in prerequisites saves significant time compared to waiting for
 // deployment to fail in phase 5 (CR deployment).
 func TestCheckDependencies_NamingCompliance(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	// Track validation failures
@@ -907,8 +1056,11 @@ func TestCheckDependencies_NamingCompliance(t *testing.T) {
 }
 
 // TestCheckDependencies_AzureRegion validates that the configured Azure region is valid.
-// This catches invalid region configurations early before deployment begins.
+// The region is checked against the live location list from az account list-locations,
+// so a typo fails here instead of after a failed deployment.
 func TestCheckDependencies_AzureRegion(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 	if !config.HasProvider("aro") {
 		t.Skip("Skipping Azure region validation (provider is not aro)")
@@ -920,16 +1072,127 @@ func TestCheckDependencies_AzureRegion(t *testing.T) {
 		return
 	}
 
-	if err := ValidateAzureRegion(t, config.Region); err != nil {
-		t.Errorf("Azure region validation failed:\n%v", err)
+	if err := CheckRegionValid(t, config.Region); err != nil {
+		t.Fatalf("Azure region validation failed:\n%v", err)
 	} else {
 		t.Logf("Azure region '%s' is valid", config.Region)
 	}
 }
 
+// TestCheckDependencies_MachineSKU validates that MACHINE_SKU is offered and not restricted
+// in the configured region, preventing a SkuNotAvailable failure mid-deployment.
+func TestCheckDependencies_MachineSKU(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+	if !config.HasProvider("aro") {
+		t.Skip("Skipping machine SKU validation (provider is not aro)")
+	}
+
+	if config.MachineSKU == "" {
+		t.Skip("MACHINE_SKU not set, using the generator's default VM size")
+	}
+
+	// Skip in CI environments where Azure may not be available
+	if os.Getenv("CI") == "true" || os.Getenv("GITHUB_ACTIONS") == "true" {
+		t.Skip("Skipping machine SKU validation in CI environment")
+	}
+
+	if !CommandExists("az") {
+		t.Skip("Azure CLI not available, skipping machine SKU validation")
+	}
+
+	if err := CheckSKUAvailable(t, config.Region, config.MachineSKU); err != nil {
+		t.Fatalf("Machine SKU validation failed:\n%v", err)
+	}
+	t.Logf("Machine SKU '%s' is available in region '%s'", config.MachineSKU, config.Region)
+}
+
+// TestCheckDependencies_AzureResourceProviders validates that the resource providers ARO HCP
+// depends on are registered on the subscription, a one-time setup step that otherwise only
+// surfaces as a late deployment failure.
+func TestCheckDependencies_AzureResourceProviders(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+	if !config.HasProvider("aro") {
+		t.Skip("Skipping Azure resource provider validation (provider is not aro)")
+	}
+
+	// Skip in CI environments where Azure credentials may not be available
+	if os.Getenv("CI") == "true" || os.Getenv("GITHUB_ACTIONS") == "true" {
+		t.Skip("Skipping Azure resource provider validation in CI environment")
+	}
+
+	if !CommandExists("az") {
+		t.Skip("Azure CLI not available, skipping resource provider validation")
+	}
+
+	if err := CheckResourceProvidersRegistered(t, RequiredAzureResourceProviders); err != nil {
+		t.Fatalf("Azure resource provider validation failed:\n%v", err)
+	}
+	t.Logf("All %d required Azure resource providers are registered", len(RequiredAzureResourceProviders))
+}
+
+// TestCheckDependencies_ServicePrincipalRoles validates that the service principal used by
+// CAPZ holds Contributor (or Owner) on the subscription, or on the resource group when
+// deploying into an existing one. An under-privileged identity otherwise only fails after
+// a long provisioning attempt.
+func TestCheckDependencies_ServicePrincipalRoles(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+	if !config.HasProvider("aro") {
+		t.Skip("Skipping service principal role validation (provider is not aro)")
+	}
+
+	// Skip in CI environments where Azure credentials may not be available
+	if os.Getenv("CI") == "true" || os.Getenv("GITHUB_ACTIONS") == "true" {
+		t.Skip("Skipping service principal role validation in CI environment")
+	}
+
+	if !CommandExists("az") {
+		t.Skip("Azure CLI not available, skipping service principal role validation")
+	}
+
+	// Prefer the identity baked into previously generated credentials, since that is what
+	// CAPZ will actually use; fall back to the environment before generation has run.
+	credentialsPath := filepath.Join(config.RepoDir, config.GetOutputDirName(), "credentials.yaml")
+	clientID, err := ExtractClientIDFromYAML(credentialsPath)
+	if err != nil {
+		clientID = os.Getenv("AZURE_CLIENT_ID")
+	}
+	if clientID == "" {
+		t.Skip("No service principal client ID (AZURE_CLIENT_ID or generated credentials.yaml), skipping role validation")
+	}
+
+	subscriptionID := os.Getenv("AZURE_SUBSCRIPTION_ID")
+	if subscriptionID == "" {
+		output, err := RunCommandQuiet(t, "az", "account", "show", "--query", "id", "-o", "tsv")
+		if err == nil {
+			subscriptionID = strings.TrimSpace(output)
+		}
+	}
+	if subscriptionID == "" {
+		t.Skip("No Azure subscription ID available, skipping role validation")
+	}
+
+	scope := "/subscriptions/" + subscriptionID
+	if config.UseExistingRG {
+		scope += "/resourceGroups/" + config.ResourceGroupName
+	}
+
+	if err := CheckServicePrincipalRoles(t, clientID, scope); err != nil {
+		t.Fatalf("Service principal role validation failed:\n%v", err)
+	}
+	t.Logf("Service principal '%s' has sufficient role on %s", clientID, scope)
+}
+
 // TestCheckDependencies_AzureSubscriptionAccess validates that the Azure subscription is accessible.
 // This ensures the subscription exists and the current credentials have access before deployment.
 func TestCheckDependencies_AzureSubscriptionAccess(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 	if !config.HasProvider("aro") {
 		t.Skip("Skipping Azure subscription access validation (provider is not aro)")
@@ -973,6 +1236,8 @@ func TestCheckDependencies_AzureSubscriptionAccess(t *testing.T) {
 // TestCheckDependencies_TimeoutConfiguration validates that timeout configurations are reasonable.
 // This catches potentially problematic timeout values (too short or too long) before deployment.
 func TestCheckDependencies_TimeoutConfiguration(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	t.Run("ClusterDeploymentTimeout", func(t *testing.T) {
@@ -1008,6 +1273,8 @@ func TestCheckDependencies_TimeoutConfiguration(t *testing.T) {
 // This test runs all validation checks and provides a summary of the configuration status.
 // It's designed to give users a complete picture of their configuration at the start of testing.
 func TestCheckDependencies_ComprehensiveValidation(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	// Run all validations
@@ -1088,3 +1355,38 @@ func getToolInstallInstructions(tool string) string {
 	}
 	return fmt.Sprintf("Please install '%s' and ensure it is in your PATH.", tool)
 }
+
+// checkDependenciesPhaseSteps returns this file's tests in the order they must run.
+func checkDependenciesPhaseSteps() []e2eStep {
+	return []e2eStep{
+		{name: "ToolAvailable", run: TestCheckDependencies_ToolAvailable},
+		{name: "OptionalTools", run: TestCheckDependencies_OptionalTools},
+		{name: "MCEAuthentication", run: TestCheckDependencies_MCEAuthentication},
+		{name: "ExternalKubeconfig", run: TestCheckDependencies_ExternalKubeconfig},
+		{name: "KubeconfigMerge", run: TestCheckDependencies_KubeconfigMerge},
+		{name: "ContainerRuntimeRunning", run: TestCheckDependencies_ContainerRuntimeRunning},
+		{name: "DiskSpace", run: TestCheckDependencies_DiskSpace},
+		{name: "PythonVersion", run: TestCheckDependencies_PythonVersion},
+		{name: "AzureAuthentication", run: TestCheckDependencies_AzureAuthentication},
+		{name: "AzureEnvironment", run: TestCheckDependencies_AzureEnvironment},
+		{name: "OpenShiftCLI_IsAvailable", run: TestCheckDependencies_OpenShiftCLI_IsAvailable},
+		{name: "Helm_IsAvailable", run: TestCheckDependencies_Helm_IsAvailable},
+		{name: "Kind_IsAvailable", run: TestCheckDependencies_Kind_IsAvailable},
+		{name: "Clusterctl_IsAvailable", run: TestCheckDependencies_Clusterctl_IsAvailable},
+		{name: "NamingConstraints", run: TestCheckDependencies_NamingConstraints},
+		{name: "DockerCredentialHelper", run: TestCheckDependencies_DockerCredentialHelper},
+		{name: "NamingCompliance", run: TestCheckDependencies_NamingCompliance},
+		{name: "AzureRegion", run: TestCheckDependencies_AzureRegion},
+		{name: "MachineSKU", run: TestCheckDependencies_MachineSKU},
+		{name: "AzureResourceProviders", run: TestCheckDependencies_AzureResourceProviders},
+		{name: "ServicePrincipalRoles", run: TestCheckDependencies_ServicePrincipalRoles},
+		{name: "AzureSubscriptionAccess", run: TestCheckDependencies_AzureSubscriptionAccess},
+		{name: "TimeoutConfiguration", run: TestCheckDependencies_TimeoutConfiguration},
+		{name: "ComprehensiveValidation", run: TestCheckDependencies_ComprehensiveValidation},
+	}
+}
+
+// TestCheckDependencies_ZZZ_RunPhase runs checkDependenciesPhaseSteps in order via runOrderedPhase.
+func TestCheckDependencies_ZZZ_RunPhase(t *testing.T) {
+	runOrderedPhase(t, checkDependenciesPhaseSteps())
+}
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -10,11 +11,15 @@ import (
 // TestSetup_CloneRepository tests cloning the cluster-api-installer repository.
 // The repository is needed for YAML generation even in external cluster mode.
 func TestSetup_CloneRepository(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	// Check if config initialization failed
 	if configError != nil {
 		t.Fatalf("Configuration initialization failed: %s", *configError)
 	}
 
+	CheckToolsForPhase(t, "setup")
+
 	config := NewTestConfig()
 
 	// Note: We still need the repo in external cluster mode for YAML generation (Phase 04)
@@ -43,6 +48,10 @@ func TestSetup_CloneRepository(t *testing.T) {
 			t.Logf("Repository HEAD: %s", headSHA[:min(12, len(headSHA))])
 		}
 
+		// Refresh the existing checkout so it tracks the configured branch
+		// instead of blindly reusing whatever was left there by a previous run
+		refreshExistingRepository(t, config)
+
 		// Register the existing repository for tracking in test output
 		RegisterClonedRepository(config.RepoURL, config.RepoBranch, config.RepoDir)
 
@@ -51,9 +60,13 @@ func TestSetup_CloneRepository(t *testing.T) {
 	}
 
 	// Clone the repository
-	t.Logf("Cloning repository from %s (branch: %s)", config.RepoURL, config.RepoBranch)
+	if config.CloneDepth > 0 {
+		t.Logf("Cloning repository from %s (branch: %s, depth: %d)", config.RepoURL, config.RepoBranch, config.CloneDepth)
+	} else {
+		t.Logf("Cloning repository from %s (branch: %s)", config.RepoURL, config.RepoBranch)
+	}
 
-	output, err := RunCommand(t, "git", "clone", "-b", config.RepoBranch, config.RepoURL, config.RepoDir)
+	output, err := RunCommand(t, "git", GitCloneArgs(config.RepoURL, config.RepoBranch, config.RepoDir, config.CloneDepth)...)
 	if err != nil {
 		t.Errorf("Failed to clone repository: %v\nOutput: %s", err, output)
 		return
@@ -65,8 +78,106 @@ func TestSetup_CloneRepository(t *testing.T) {
 	t.Logf("Repository cloned successfully to %s", config.RepoDir)
 }
 
+// refreshExistingRepository fetches the configured branch into an existing checkout and
+// makes sure it is checked out. Fetch and update failures are logged as warnings so that
+// offline re-runs can still use the existing repository.
+func refreshExistingRepository(t *testing.T, config *TestConfig) {
+	t.Helper()
+
+	output, err := RunCommandQuiet(t, "git", "-C", config.RepoDir, "rev-parse", "--abbrev-ref", "HEAD")
+	currentBranch := strings.TrimSpace(output)
+	if err != nil {
+		t.Logf("Warning: could not determine current branch of %s: %v", config.RepoDir, err)
+	}
+
+	t.Logf("Fetching branch %s from origin", config.RepoBranch)
+	if output, err := RunCommand(t, "git", GitFetchArgs(config.RepoDir, config.RepoBranch, config.CloneDepth)...); err != nil {
+		t.Logf("Warning: failed to fetch branch %s, using existing checkout: %v\nOutput: %s", config.RepoBranch, err, output)
+		if currentBranch != config.RepoBranch {
+			t.Logf("Warning: existing checkout is on '%s' but ARO_REPO_BRANCH is '%s'", currentBranch, config.RepoBranch)
+		}
+		return
+	}
+
+	if currentBranch != config.RepoBranch {
+		t.Logf("Warning: existing checkout is on '%s' but ARO_REPO_BRANCH is '%s', checking out %s",
+			currentBranch, config.RepoBranch, config.RepoBranch)
+		if output, err := RunCommand(t, "git", "-C", config.RepoDir, "checkout", "-B", config.RepoBranch, "FETCH_HEAD"); err != nil {
+			t.Errorf("Failed to check out branch %s: %v\nOutput: %s", config.RepoBranch, err, output)
+		}
+		return
+	}
+
+	if output, err := RunCommand(t, "git", "-C", config.RepoDir, "merge", "--ff-only", "FETCH_HEAD"); err != nil {
+		t.Logf("Warning: could not fast-forward %s to origin/%s (local changes?): %v\nOutput: %s",
+			config.RepoDir, config.RepoBranch, err, output)
+	}
+}
+
+// TestSetup_VerifyRepositoryRevision records the commit the repository is checked out at.
+// When ARO_REPO_COMMIT is set, the repository is checked out at that exact commit so that
+// test runs are reproducible against a known-good installer revision.
+func TestSetup_VerifyRepositoryRevision(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+
+	if !DirExists(config.RepoDir) {
+		t.Skipf("Repository not cloned yet at %s", config.RepoDir)
+	}
+
+	if config.RepoCommit != "" {
+		if err := ValidateGitCommitSHA(config.RepoCommit); err != nil {
+			t.Fatalf("Invalid ARO_REPO_COMMIT: %v", err)
+		}
+
+		// Fetch the commit only if it is not already available locally
+		if _, err := RunCommandQuiet(t, "git", "-C", config.RepoDir, "cat-file", "-e", config.RepoCommit+"^{commit}"); err != nil {
+			if !IsFullGitCommitSHA(config.RepoCommit) {
+				t.Fatalf("Commit %s not found locally, and git can only fetch a commit by its full SHA.\n"+
+					"Set ARO_REPO_COMMIT to the full 40-character SHA: git rev-parse %s", config.RepoCommit, config.RepoCommit)
+			}
+			t.Logf("Commit %s not found locally, fetching from origin", config.RepoCommit)
+			if output, err := RunCommand(t, "git", "-C", config.RepoDir, "fetch", "origin", config.RepoCommit); err != nil {
+				t.Fatalf("Failed to fetch commit %s: %v\nOutput: %s", config.RepoCommit, err, output)
+			}
+		}
+
+		t.Logf("Checking out pinned commit %s", config.RepoCommit)
+		if output, err := RunCommand(t, "git", "-C", config.RepoDir, "checkout", "--detach", config.RepoCommit); err != nil {
+			t.Fatalf("Failed to check out commit %s: %v\nOutput: %s", config.RepoCommit, err, output)
+		}
+	}
+
+	output, err := RunCommandQuiet(t, "git", "-C", config.RepoDir, "rev-parse", "HEAD")
+	headSHA := strings.TrimSpace(output)
+	if err != nil || headSHA == "" {
+		t.Fatalf("Failed to resolve repository HEAD: %v\nOutput: %s", err, output)
+	}
+
+	if config.RepoCommit != "" && !strings.HasPrefix(headSHA, strings.ToLower(config.RepoCommit)) {
+		t.Fatalf("Repository HEAD %s does not match pinned commit ARO_REPO_COMMIT=%s", headSHA, config.RepoCommit)
+	}
+
+	t.Logf("Repository revision: %s (branch: %s)", headSHA, config.RepoBranch)
+
+	if err := SaveRepositoryRevision(headSHA); err != nil {
+		t.Errorf("Failed to save repository revision to deployment state: %v", err)
+	}
+
+	revisionFile := filepath.Join(GetResultsDir(), RepositoryRevisionFile)
+	content := fmt.Sprintf("url: %s\nbranch: %s\ncommit: %s\n", config.RepoURL, config.RepoBranch, headSHA)
+	if err := os.WriteFile(revisionFile, []byte(content), 0600); err != nil {
+		t.Errorf("Failed to write repository revision to %s: %v", revisionFile, err)
+	} else {
+		t.Logf("Repository revision saved to %s", revisionFile)
+	}
+}
+
 // TestSetup_VerifyRepositoryStructure verifies the cloned repository has required scripts
 func TestSetup_VerifyRepositoryStructure(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	// Note: Repo is needed in external cluster mode for YAML generation
@@ -90,6 +201,8 @@ func TestSetup_VerifyRepositoryStructure(t *testing.T) {
 
 // TestSetup_ScriptPermissions verifies scripts have executable permissions
 func TestSetup_ScriptPermissions(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	// Note: Repo is needed in external cluster mode for YAML generation
@@ -126,3 +239,18 @@ func TestSetup_ScriptPermissions(t *testing.T) {
 		}
 	}
 }
+
+// setupPhaseSteps returns this file's tests in the order they must run.
+func setupPhaseSteps() []e2eStep {
+	return []e2eStep{
+		{name: "CloneRepository", run: TestSetup_CloneRepository},
+		{name: "VerifyRepositoryRevision", run: TestSetup_VerifyRepositoryRevision},
+		{name: "VerifyRepositoryStructure", run: TestSetup_VerifyRepositoryStructure},
+		{name: "ScriptPermissions", run: TestSetup_ScriptPermissions},
+	}
+}
+
+// TestSetup_ZZZ_RunPhase runs setupPhaseSteps in order via runOrderedPhase.
+func TestSetup_ZZZ_RunPhase(t *testing.T) {
+	runOrderedPhase(t, setupPhaseSteps())
+}
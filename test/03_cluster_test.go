@@ -1,6 +1,7 @@
 package test
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -17,6 +18,8 @@ func TestExternalCluster_01_Connectivity(t *testing.T) {
 		t.Fatalf("Configuration initialization failed: %s", *configError)
 	}
 
+	CheckToolsForPhase(t, "kind")
+
 	config := NewTestConfig()
 
 	if !config.IsExternalCluster() {
@@ -366,6 +369,33 @@ func TestKindCluster_01_ClusterReady(t *testing.T) {
 		output, _ = RunCommand(t, "kind", "get", "clusters")
 		clusterExists := strings.Contains(output, config.ManagementClusterName)
 		needsDeployment = !clusterExists
+
+		// An existing cluster is only reused if it is healthy; a dead cluster would
+		// otherwise make every downstream test fail in confusing ways
+		if clusterExists {
+			PrintToTTY("Management cluster '%s' exists - checking health\n", config.ManagementClusterName)
+			if healthErr := HealthCheckExistingCluster(t, config.GetKubeContext()); healthErr != nil {
+				PrintToTTY("⚠️  Existing management cluster is unhealthy: %v\n", healthErr)
+				t.Logf("Existing management cluster is unhealthy: %v", healthErr)
+
+				if !config.RecreateOnUnhealthy {
+					PrintToTTY("\nTo fix this:\n")
+					PrintToTTY("  1. Set RECREATE_ON_UNHEALTHY=true to delete and recreate it automatically, or\n")
+					PrintToTTY("  2. Delete it manually: kind delete cluster --name %s\n\n", config.ManagementClusterName)
+					t.Fatalf("Existing management cluster '%s' is unhealthy: %v (set RECREATE_ON_UNHEALTHY=true to recreate it)",
+						config.ManagementClusterName, healthErr)
+				}
+
+				PrintToTTY("🔄 RECREATE_ON_UNHEALTHY=true - deleting management cluster '%s'\n", config.ManagementClusterName)
+				if output, err := RunCommand(t, "kind", "delete", "cluster", "--name", config.ManagementClusterName); err != nil {
+					PrintToTTY("❌ Failed to delete unhealthy management cluster: %v\n", err)
+					t.Fatalf("Failed to delete unhealthy management cluster: %v\nOutput: %s", err, output)
+				}
+				needsDeployment = true
+			} else {
+				PrintToTTY("✅ Existing management cluster is healthy\n")
+			}
+		}
 	}
 
 	if needsDeployment {
@@ -382,9 +412,24 @@ func TestKindCluster_01_ClusterReady(t *testing.T) {
 			return
 		}
 
-		// Generate Kind config file for private registry access (only for Kind clusters)
+		// Generate Kind config file for private registry access (only for Kind clusters),
+		// unless the user supplied their own via KIND_CONFIG
 		var kindConfigPath string
-		if !config.IsExternalCluster() {
+		if !config.IsExternalCluster() && config.KindConfigPath != "" {
+			PrintToTTY("\n=== Using Kind cluster configuration from KIND_CONFIG ===\n")
+			kindCfg, err := ValidateKindConfig(config.KindConfigPath)
+			if err != nil {
+				PrintToTTY("❌ Invalid KIND_CONFIG: %v\n", err)
+				t.Fatalf("Invalid KIND_CONFIG: %v\n\n"+
+					"To fix this:\n"+
+					"  1. Point KIND_CONFIG at an existing kind config (kind: Cluster, apiVersion: kind.x-k8s.io/v1alpha4)\n"+
+					"  2. Or unset KIND_CONFIG to use the generated config", err)
+			}
+			kindConfigPath = config.KindConfigPath
+			PrintToTTY("✅ Kind config: %s (%d node(s))\n", kindConfigPath, kindCfg.NodeCount())
+			PrintToTTY("   Registry credentials are not mounted automatically; add an extraMount for\n")
+			PrintToTTY("   /var/lib/kubelet/config.json if private image pulls are needed\n")
+		} else if !config.IsExternalCluster() {
 			PrintToTTY("\n=== Generating Kind cluster configuration ===\n")
 			var err error
 			kindConfigPath, err = GenerateKindConfig(t, config.RepoDir, config.ManagementClusterName)
@@ -400,6 +445,22 @@ func TestKindCluster_01_ClusterReady(t *testing.T) {
 			}
 		}
 
+		// In air-gapped environments every image comes from the mirror, so an unreachable
+		// mirror would only surface minutes later as ImagePullBackOff on each controller
+		if config.RegistryMirror != "" {
+			PrintToTTY("\n=== Checking registry mirror ===\n")
+			if err := CheckRegistryMirrorReachable(RunContext(), config.RegistryMirror, config.ProxySettings()); err != nil {
+				PrintToTTY("❌ %v\n", err)
+				t.Fatalf("%v\n\n"+
+					"To fix this:\n"+
+					"  1. Check the mirror answers from this host: curl -k https://%s/v2/\n"+
+					"  2. Check COMMAND_HTTPS_PROXY / COMMAND_NO_PROXY if the mirror is behind a proxy\n"+
+					"  3. Or unset REGISTRY_MIRROR to pull from the upstream registries",
+					err, RegistryMirrorHost(config.RegistryMirror))
+			}
+			PrintToTTY("✅ Registry mirror %s is reachable\n", config.RegistryMirror)
+		}
+
 		PrintToTTY("\n=== Deploying controllers to management cluster ===\n")
 		PrintToTTY("Expected duration: 5-10 minutes\n")
 		PrintToTTY("Output streaming below...\n\n")
@@ -417,7 +478,7 @@ func TestKindCluster_01_ClusterReady(t *testing.T) {
 			// instead of defaulting to "crc-admin" (which doesn't exist on IPI clusters).
 			SetEnvVar(t, "OCP_CONTEXT", config.GetKubeContext())
 		} else {
-			SetEnvVar(t, "KIND_CLUSTER_NAME", config.ManagementClusterName)
+			SetEnvVar(t, EnvGenKindClusterName, config.ManagementClusterName)
 			SetEnvVar(t, "DO_INIT_KIND", "true")
 		}
 		SetEnvVar(t, "DO_DEPLOY", "true")
@@ -428,6 +489,10 @@ func TestKindCluster_01_ClusterReady(t *testing.T) {
 		SetEnvVar(t, "DO_CHECK", "false")
 		// Format Go duration as a Helm-compatible duration string (e.g., "10m0s")
 		SetEnvVar(t, "HELM_INSTALL_TIMEOUT", config.HelmInstallTimeout.String())
+		// Point the installer's controller and cert-manager images at the mirror
+		if config.RegistryMirror != "" {
+			SetEnvVar(t, "REGISTRY_MIRROR", config.RegistryMirror)
+		}
 		// Pass generated Kind config to setup-kind-cluster.sh so it uses our
 		// config with Docker credentials mounted for private registry access
 		if kindConfigPath != "" {
@@ -462,9 +527,16 @@ func TestKindCluster_01_ClusterReady(t *testing.T) {
 		scriptArgs := append([]string{deployScriptPath}, chartArgs...)
 		t.Logf("Executing deployment script: %s %s", deployScriptPath, strings.Join(chartArgs, " "))
 		t.Log("This will: deploy CAPI and infrastructure provider controllers to management cluster")
-		output, err = RunCommandWithStreaming(t, "bash", scriptArgs...)
+		result, err := RunCommandWithStreaming(t, "bash", scriptArgs...)
+		output = result.Output
 		if err != nil {
-			PrintToTTY("\n❌ Failed to deploy controllers: %v\n", err)
+			PrintToTTY("\n❌ Failed to deploy controllers: deployment script %s\n", result.Describe())
+			if result.Signaled() {
+				// Killed rather than failing on its own: the output is truncated and
+				// may not contain the real error
+				PrintToTTY("The script was terminated externally (timeout or interrupt) after %v; output may be incomplete\n",
+					result.Duration.Round(time.Second))
+			}
 
 			// Check for known provider errors
 			if config.HasProvider("aro") {
@@ -474,7 +546,7 @@ func TestKindCluster_01_ClusterReady(t *testing.T) {
 				}
 			}
 
-			t.Errorf("Failed to deploy controllers: %v\nOutput: %s", err, output)
+			t.Errorf("Failed to deploy controllers: deployment script %s: %v\nOutput: %s", result.Describe(), err, output)
 			return
 		}
 
@@ -512,8 +584,8 @@ func TestKindCluster_01_ClusterReady(t *testing.T) {
 			PrintToTTY("✅ AWS credentials available\n\n")
 		}
 	} else {
-		PrintToTTY("✅ Management cluster already exists (controllers assumed deployed)\n\n")
-		t.Log("Management cluster already exists (controllers assumed deployed)")
+		PrintToTTY("✅ Management cluster already exists and is healthy (skipping deployment)\n\n")
+		t.Log("Management cluster already exists and is healthy (skipping deployment)")
 	}
 
 	// Verify cluster is accessible via kubectl
@@ -547,6 +619,49 @@ func TestKindCluster_01_ClusterReady(t *testing.T) {
 	}
 }
 
+// TestKindCluster_01b_NodeCountMatchesKindConfig verifies that the Kind management cluster
+// has as many nodes as the KIND_CONFIG file declares, confirming the user-supplied config
+// (e.g., multiple workers) actually reached the deploy script.
+func TestKindCluster_01b_NodeCountMatchesKindConfig(t *testing.T) {
+	config := NewTestConfig()
+
+	if config.KindConfigPath == "" {
+		t.Skip("KIND_CONFIG not set, management cluster uses the generated Kind config")
+	}
+	if config.IsExternalCluster() {
+		t.Skip("Using external cluster (USE_KUBECONFIG set), KIND_CONFIG does not apply")
+	}
+
+	PrintTestHeader(t, "TestKindCluster_01b_NodeCountMatchesKindConfig",
+		"Verify management cluster node count matches KIND_CONFIG")
+
+	kindCfg, err := ValidateKindConfig(config.KindConfigPath)
+	if err != nil {
+		t.Fatalf("Invalid KIND_CONFIG: %v", err)
+	}
+	expected := kindCfg.NodeCount()
+
+	output, err := RunCommand(t, "kubectl", "--context", config.GetKubeContext(), "get", "nodes", "-o", "name")
+	if err != nil {
+		t.Fatalf("Failed to list management cluster nodes: %v\nOutput: %s", err, output)
+	}
+	actual := len(strings.Fields(output))
+
+	if actual != expected {
+		PrintToTTY("❌ Management cluster has %d node(s), KIND_CONFIG declares %d\n\n", actual, expected)
+		t.Errorf("Management cluster '%s' has %d node(s), but KIND_CONFIG %s declares %d.\n\n"+
+			"The cluster may predate KIND_CONFIG or the deploy script ignored it.\n"+
+			"To fix this:\n"+
+			"  1. Delete the cluster: kind delete cluster --name %s\n"+
+			"  2. Re-run to recreate it from KIND_CONFIG",
+			config.ManagementClusterName, actual, config.KindConfigPath, expected, config.ManagementClusterName)
+		return
+	}
+
+	PrintToTTY("✅ Management cluster has %d node(s) as declared in KIND_CONFIG\n\n", actual)
+	t.Logf("Management cluster has %d node(s) matching KIND_CONFIG", actual)
+}
+
 // TestKindCluster_02_ControllersInstalled validates that controller deployments exist.
 // This runs AFTER TestKindCluster_01_ClusterReady, so controllers should be deployed.
 func TestKindCluster_02_ControllersInstalled(t *testing.T) {
@@ -696,88 +811,48 @@ func TestKindCluster_CAPIControllerReady(t *testing.T) {
 	PrintToTTY("Deployment: %s\n", CAPIControllerDeployment)
 	PrintToTTY("Timeout: %v | Poll interval: %v\n\n", timeout, pollInterval)
 
-	iteration := 0
-	for {
-		elapsed := time.Since(startTime)
-		remaining := timeout - elapsed
-
-		if elapsed > timeout {
-			PrintToTTY("\n❌ Timeout reached after %v\n\n", elapsed.Round(time.Second))
-
-			// Dump diagnostic info to help identify the root cause
-			PrintToTTY("=== Diagnostic: pod status in %s ===\n", config.CAPINamespace)
-			if podOutput, podErr := RunCommand(t, "kubectl", "--context", context, "-n", config.CAPINamespace, "--request-timeout=30s", "get", "pods", "-o", "wide"); podErr == nil {
-				PrintToTTY("%s\n", podOutput)
-			}
-			PrintToTTY("=== Diagnostic: pod descriptions in %s ===\n", config.CAPINamespace)
-			if descOutput, descErr := RunCommand(t, "kubectl", "--context", context, "-n", config.CAPINamespace, "--request-timeout=30s", "describe", "pods"); descErr == nil {
-				PrintToTTY("%s\n", descOutput)
-			}
-			PrintToTTY("=== Diagnostic: events in %s ===\n", config.CAPINamespace)
-			if evtOutput, evtErr := RunCommand(t, "kubectl", "--context", context, "-n", config.CAPINamespace, "--request-timeout=30s", "get", "events", "--sort-by=.lastTimestamp"); evtErr == nil {
-				PrintToTTY("%s\n", evtOutput)
-			}
-
-			t.Errorf("Timeout waiting for CAPI controller manager to be available after %v.\n\n"+
-				"Common causes:\n"+
-				"  - Image pull issues (check pod descriptions above)\n"+
-				"  - Insufficient resources on Kind node\n"+
-				"  - cert-manager not ready (controllers depend on it for webhooks)",
-				elapsed.Round(time.Second))
-			return
-		}
-
-		iteration++
+	err := WaitForDeploymentAvailable(t, context, config.CAPINamespace, CAPIControllerDeployment, timeout, pollInterval)
+	if err != nil {
+		failImagePulls(t, context, ControllerDef{DisplayName: "CAPI", Namespace: config.CAPINamespace,
+			DeploymentName: CAPIControllerDeployment, PodSelector: CAPIPodSelector})
+	}
+	if errors.Is(err, ErrPollTimeout) {
+		t.Errorf("Timeout waiting for CAPI controller manager to be available: %v\n\n"+
+			"Common causes:\n"+
+			"  - Image pull issues (check pod descriptions above)\n"+
+			"  - Insufficient resources on Kind node\n"+
+			"  - cert-manager not ready (controllers depend on it for webhooks)",
+			err)
+		return
+	}
+	if err != nil {
+		t.Fatalf("CAPI controller manager failed to become available: %v", err)
+	}
 
-		PrintToTTY("[%d] Checking deployment status...\n", iteration)
+	PrintToTTY("\n✅ CAPI controller manager is available! (took %v)\n\n", time.Since(startTime).Round(time.Second))
+	t.Log("CAPI controller manager deployment is available")
 
-		output, err := RunCommand(t, "kubectl", "--context", context, "-n", config.CAPINamespace,
-			"get", "deployment", CAPIControllerDeployment,
+	// Also check mce-capi-webhook-config when not in Kind/K8S mode
+	if os.Getenv("USE_KIND") != "true" && os.Getenv("USE_K8S") != "true" {
+		PrintToTTY("Checking mce-capi-webhook-config deployment...\n")
+		mceOutput, mceErr := RunCommand(t, "kubectl", "--context", context, "-n", config.CAPINamespace,
+			"get", "deployment", "mce-capi-webhook-config",
 			"-o", "jsonpath={.status.conditions[?(@.type=='Available')].status}")
-
-		if err != nil {
-			PrintToTTY("[%d] ⚠️  Status check failed: %v\n", iteration, err)
+		if mceErr != nil {
+			PrintToTTY("⚠️  MCE webhook config check failed: %v\n", mceErr)
+		} else if strings.TrimSpace(mceOutput) == "True" {
+			PrintToTTY("✅ MCE webhook config is available\n\n")
 		} else {
-			status := strings.TrimSpace(output)
-			PrintToTTY("[%d] 📊 Deployment Available status: %s\n", iteration, status)
-
-			if status == "True" {
-				PrintToTTY("\n✅ CAPI controller manager is available! (took %v)\n\n", elapsed.Round(time.Second))
-				t.Log("CAPI controller manager deployment is available")
-
-				// Also check mce-capi-webhook-config when not in Kind/K8S mode
-				if os.Getenv("USE_KIND") != "true" && os.Getenv("USE_K8S") != "true" {
-					PrintToTTY("Checking mce-capi-webhook-config deployment...\n")
-					mceOutput, mceErr := RunCommand(t, "kubectl", "--context", context, "-n", config.CAPINamespace,
-						"get", "deployment", "mce-capi-webhook-config",
-						"-o", "jsonpath={.status.conditions[?(@.type=='Available')].status}")
-					if mceErr != nil {
-						PrintToTTY("⚠️  MCE webhook config check failed: %v\n", mceErr)
-					} else if strings.TrimSpace(mceOutput) == "True" {
-						PrintToTTY("✅ MCE webhook config is available\n\n")
-					} else {
-						PrintToTTY("⚠️  MCE webhook config not yet available\n\n")
-					}
-				}
-				return
-			}
+			PrintToTTY("⚠️  MCE webhook config not yet available\n\n")
 		}
-
-		ReportProgress(t, iteration, elapsed, remaining, timeout)
-
-		if imgErr := CheckPodsForImagePullErrors(t, context, config.CAPINamespace); imgErr != nil {
-			PrintToTTY("\n❌ Image pull errors detected — failing fast\n")
-			t.Fatalf("Controller pods have image pull errors in %s namespace.\n%v",
-				config.CAPINamespace, imgErr)
-		}
-
-		time.Sleep(pollInterval)
 	}
 }
 
 // TestKindCluster_InfraControllersReady waits for all infrastructure provider controllers to be ready.
 // This iterates over all configured providers and validates each controller deployment.
 func TestKindCluster_InfraControllersReady(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	// Set KUBECONFIG for external cluster mode
@@ -802,70 +877,158 @@ func TestKindCluster_InfraControllersReady(t *testing.T) {
 				PrintToTTY("Deployment: %s\n", ctrl.DeploymentName)
 				PrintToTTY("Timeout: %v | Poll interval: %v\n\n", timeout, pollInterval)
 
-				iteration := 0
-				for {
-					elapsed := time.Since(startTime)
-					remaining := timeout - elapsed
-
-					if elapsed > timeout {
-						PrintToTTY("\n❌ Timeout reached after %v\n\n", elapsed.Round(time.Second))
+				err := WaitForDeploymentAvailable(t, context, ctrl.Namespace, ctrl.DeploymentName, timeout, pollInterval)
+				if err != nil {
+					failImagePulls(t, context, ctrl)
+				}
+				if errors.Is(err, ErrPollTimeout) {
+					t.Errorf("Timeout waiting for %s controller manager to be available: %v\n\n"+
+						"Common causes:\n"+
+						"  - CAPI controller not ready yet (infrastructure providers depend on CAPI)\n"+
+						"  - Credentials not configured\n"+
+						"  - Image pull issues (check pod descriptions above)",
+						ctrl.DisplayName, err)
+					return
+				}
+				if err != nil {
+					t.Fatalf("%s controller manager failed to become available: %v", ctrl.DisplayName, err)
+				}
 
-						// Dump diagnostic info to help identify the root cause
-						PrintToTTY("=== Diagnostic: pod status in %s ===\n", ctrl.Namespace)
-						if podOutput, podErr := RunCommand(t, "kubectl", "--context", context, "-n", ctrl.Namespace, "--request-timeout=30s", "get", "pods", "-o", "wide"); podErr == nil {
-							PrintToTTY("%s\n", podOutput)
-						}
-						PrintToTTY("=== Diagnostic: pod descriptions in %s ===\n", ctrl.Namespace)
-						if descOutput, descErr := RunCommand(t, "kubectl", "--context", context, "-n", ctrl.Namespace, "--request-timeout=30s", "describe", "pods"); descErr == nil {
-							PrintToTTY("%s\n", descOutput)
-						}
-						PrintToTTY("=== Diagnostic: events in %s ===\n", ctrl.Namespace)
-						if evtOutput, evtErr := RunCommand(t, "kubectl", "--context", context, "-n", ctrl.Namespace, "--request-timeout=30s", "get", "events", "--sort-by=.lastTimestamp"); evtErr == nil {
-							PrintToTTY("%s\n", evtOutput)
-						}
+				PrintToTTY("\n✅ %s controller manager is available! (took %v)\n\n", ctrl.DisplayName, time.Since(startTime).Round(time.Second))
+				t.Logf("%s controller manager deployment is available", ctrl.DisplayName)
+			})
+		}
+	}
+}
 
-						t.Errorf("Timeout waiting for %s controller manager to be available after %v.\n\n"+
-							"Common causes:\n"+
-							"  - CAPI controller not ready yet (infrastructure providers depend on CAPI)\n"+
-							"  - Credentials not configured\n"+
-							"  - Image pull issues (check pod descriptions above)",
-							ctrl.DisplayName, elapsed.Round(time.Second))
-						return
-					}
+// failImagePulls fails the test naming the image and registry error when ctrl's pods are
+// stuck pulling their image, so a controller wait ends with "CAPZ image pull failed:
+// unauthorized" rather than a bare timeout. It returns when no pull failure is found.
+func failImagePulls(t *testing.T, context string, ctrl ControllerDef) {
+	t.Helper()
 
-					iteration++
+	failures := CheckControllerImagePulls(t, context, []ControllerDef{ctrl})
+	if len(failures) == 0 {
+		return
+	}
+	PrintToTTY("❌ %s\n", failures[0])
+	t.Fatalf("%s\n\n"+
+		"To fix this:\n"+
+		"  1. unauthorized: check the pull secret / Docker config for the registry (e.g. registry.redhat.io)\n"+
+		"  2. image not found: check the image tag the installer deployed: kubectl --context %s -n %s get deployment %s -o jsonpath='{.spec.template.spec.containers[*].image}'\n"+
+		"  3. rate limited or timeout: retry, or mirror the image to a registry you control",
+		FormatImagePullFailures(failures), context, ctrl.Namespace, ctrl.DeploymentName)
+}
 
-					PrintToTTY("[%d] Checking deployment status...\n", iteration)
+// TestKindCluster_ControllerImagesPinned verifies that each controller deployment runs the
+// image pinned by its EXPECTED_<NAME>_IMAGE variable (e.g. EXPECTED_CAPZ_IMAGE). This catches
+// silent image drift when the installer defaults to a different registry or tag than the
+// release candidate under test. Controllers without a pinned image are not checked.
+func TestKindCluster_ControllerImagesPinned(t *testing.T) {
+	PrintTestHeader(t, "TestKindCluster_ControllerImagesPinned",
+		"Verify controllers run the pinned images (EXPECTED_<NAME>_IMAGE)")
 
-					output, err := RunCommand(t, "kubectl", "--context", context, "-n", ctrl.Namespace,
-						"get", "deployment", ctrl.DeploymentName,
-						"-o", "jsonpath={.status.conditions[?(@.type=='Available')].status}")
+	config := NewTestConfig()
 
-					if err != nil {
-						PrintToTTY("[%d] ⚠️  Status check failed: %v\n", iteration, err)
-					} else {
-						status := strings.TrimSpace(output)
-						PrintToTTY("[%d] 📊 Deployment Available status: %s\n", iteration, status)
+	controllers := config.AllControllers()
+	pinned := 0
+	for _, ctrl := range controllers {
+		if os.Getenv(ExpectedImageEnvVar(ctrl.DisplayName)) != "" {
+			pinned++
+		}
+	}
+	if pinned == 0 {
+		t.Skipf("No expected controller images set (e.g. %s), skipping image check",
+			ExpectedImageEnvVar("CAPZ"))
+	}
 
-						if status == "True" {
-							PrintToTTY("\n✅ %s controller manager is available! (took %v)\n\n", ctrl.DisplayName, elapsed.Round(time.Second))
-							t.Logf("%s controller manager deployment is available", ctrl.DisplayName)
-							return
-						}
-					}
+	// Set KUBECONFIG for external cluster mode
+	if config.IsExternalCluster() {
+		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
+	}
 
-					ReportProgress(t, iteration, elapsed, remaining, timeout)
+	context := config.GetKubeContext()
 
-					if imgErr := CheckPodsForImagePullErrors(t, context, ctrl.Namespace); imgErr != nil {
-						PrintToTTY("\n❌ Image pull errors detected — failing fast\n")
-						t.Fatalf("%s controller pods have image pull errors.\n%v",
-							ctrl.DisplayName, imgErr)
-					}
+	PrintToTTY("\n=== Verifying controller images ===\n")
 
-					time.Sleep(pollInterval)
-				}
-			})
+	for _, ctrl := range controllers {
+		envVar := ExpectedImageEnvVar(ctrl.DisplayName)
+		expected := os.Getenv(envVar)
+		if expected == "" {
+			PrintToTTY("⏭️  %s: %s not set, skipping\n", ctrl.DisplayName, envVar)
+			continue
 		}
+
+		t.Run(ctrl.DisplayName, func(t *testing.T) {
+			actual, err := GetDeploymentImage(t, context, ctrl.Namespace, ctrl.DeploymentName)
+			if err != nil {
+				PrintToTTY("❌ %s: %v\n", ctrl.DisplayName, err)
+				t.Fatalf("Failed to get %s controller image from %s/%s: %v",
+					ctrl.DisplayName, ctrl.Namespace, ctrl.DeploymentName, err)
+			}
+
+			expectedRegistry, expectedTag := SplitImageReference(expected)
+			if err := CheckImageMatches(actual, expectedRegistry, expectedTag); err != nil {
+				PrintToTTY("❌ %s: %v\n", ctrl.DisplayName, err)
+				t.Errorf("%s controller is not running the pinned image: %v\n\n"+
+					"To fix this:\n"+
+					"  1. Check which image the installer deployed: kubectl --context %s -n %s get deployment %s -o jsonpath='{.spec.template.spec.containers[0].image}'\n"+
+					"  2. Make sure the installer branch points at the expected registry and tag\n"+
+					"  3. Or update %s if the pin is out of date",
+					ctrl.DisplayName, err, context, ctrl.Namespace, ctrl.DeploymentName, envVar)
+				return
+			}
+
+			PrintToTTY("✅ %s: %s\n", ctrl.DisplayName, actual)
+			t.Logf("%s controller runs pinned image %s", ctrl.DisplayName, actual)
+		})
+	}
+}
+
+// TestKindCluster_ControllerImagesUseMirror verifies that, when REGISTRY_MIRROR is set, every
+// controller deployment runs an image from the mirror. An image still referencing the upstream
+// registry means the installer ignored the mirror and the deployment only works while that
+// registry is reachable, which defeats testing a disconnected environment.
+func TestKindCluster_ControllerImagesUseMirror(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+	if config.RegistryMirror == "" {
+		t.Skip("REGISTRY_MIRROR not set, skipping mirror image check")
+	}
+
+	// Set KUBECONFIG for external cluster mode
+	if config.IsExternalCluster() {
+		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
+	}
+
+	context := config.GetKubeContext()
+
+	PrintToTTY("\n=== Verifying controller images use registry mirror %s ===\n", config.RegistryMirror)
+
+	for _, ctrl := range config.AllControllers() {
+		t.Run(ctrl.DisplayName, func(t *testing.T) {
+			actual, err := GetDeploymentImage(t, context, ctrl.Namespace, ctrl.DeploymentName)
+			if err != nil {
+				PrintToTTY("❌ %s: %v\n", ctrl.DisplayName, err)
+				t.Fatalf("Failed to get %s controller image from %s/%s: %v",
+					ctrl.DisplayName, ctrl.Namespace, ctrl.DeploymentName, err)
+			}
+
+			if err := CheckImageMatches(actual, config.RegistryMirror, ""); err != nil {
+				PrintToTTY("❌ %s: %v\n", ctrl.DisplayName, err)
+				t.Errorf("%s controller is not running an image from the registry mirror: %v\n\n"+
+					"To fix this:\n"+
+					"  1. Check the installer supports REGISTRY_MIRROR for this chart\n"+
+					"  2. Check the image was mirrored: skopeo inspect docker://%s/...\n"+
+					"  3. Redeploy the controllers: kind delete cluster --name %s && go test -v ./test -run TestKindCluster",
+					ctrl.DisplayName, err, config.RegistryMirror, config.ManagementClusterName)
+				return
+			}
+
+			PrintToTTY("✅ %s: %s\n", ctrl.DisplayName, actual)
+			t.Logf("%s controller runs mirrored image %s", ctrl.DisplayName, actual)
+		})
 	}
 }
 
@@ -911,59 +1074,70 @@ func TestKindCluster_WebhooksReady(t *testing.T) {
 
 	for _, wh := range webhooks {
 		startTime := time.Now()
-		iteration := 0
 
 		PrintToTTY("\n--- Checking %s webhook ---\n", wh.DisplayName)
 		PrintToTTY("Service: %s.%s.svc:%d\n", wh.ServiceName, wh.Namespace, wh.Port)
 
-		for {
-			elapsed := time.Since(startTime)
-
-			if elapsed > timeout {
-				PrintToTTY("\n❌ Timeout waiting for %s webhook after %v\n", wh.DisplayName, elapsed.Round(time.Second))
-				t.Errorf("Timeout waiting for %s webhook to be responsive after %v.\n\n"+
-					"Troubleshooting steps:\n"+
-					"  1. Check webhook service exists: kubectl --context %s -n %s get svc %s\n"+
-					"  2. Check endpoint has addresses: kubectl --context %s -n %s get endpoints %s\n"+
-					"  3. Check controller pod is running: kubectl --context %s -n %s get pods\n"+
-					"  4. Check for certificate issues: kubectl --context %s get certificates -A\n\n"+
-					"Common causes:\n"+
-					"  - Controller manager pod not running or crashing\n"+
-					"  - cert-manager hasn't issued webhook certificate yet\n"+
-					"  - Service selector doesn't match pod labels",
-					wh.DisplayName, elapsed.Round(time.Second),
-					context, wh.Namespace, wh.ServiceName,
-					context, wh.Namespace, wh.ServiceName,
-					context, wh.Namespace,
-					context)
-				break
-			}
-
-			iteration++
-
-			// First check if endpoint exists and has addresses
+		var endpointIP string
+		err := PollUntil(RunContext(), t, timeout, pollInterval, func() (bool, string, error) {
+			// Endpoint addresses only contain pods that pass their readiness probe.
+			// If an IP is present, the backing pod is Ready and the webhook is serving.
 			endpointOutput, err := RunCommandQuiet(t, "kubectl", "--context", context,
 				"get", "endpoints", wh.ServiceName, "-n", wh.Namespace,
 				"-o", "jsonpath={.subsets[0].addresses[0].ip}")
-
-			if err != nil || strings.TrimSpace(endpointOutput) == "" {
-				PrintToTTY("[%d] ⏳ Waiting for %s endpoint to have addresses...\n", iteration, wh.DisplayName)
-				time.Sleep(pollInterval)
-				continue
+			endpointIP = strings.TrimSpace(endpointOutput)
+			if err != nil || endpointIP == "" {
+				return false, fmt.Sprintf("waiting for %s endpoint to have addresses", wh.DisplayName), nil
 			}
-
-			endpointIP := strings.TrimSpace(endpointOutput)
-			PrintToTTY("[%d] 📊 %s endpoint IP: %s\n", iteration, wh.DisplayName, endpointIP)
-
-			// Endpoint addresses only contain pods that pass their readiness probe.
-			// If an IP is present, the backing pod is Ready and the webhook is serving.
-			PrintToTTY("[%d] ✅ %s webhook is ready (endpoint %s) - took %v\n",
-				iteration, wh.DisplayName, endpointIP, elapsed.Round(time.Second))
-			t.Logf("%s webhook is ready (endpoint %s)", wh.DisplayName, endpointIP)
-			break
+			return true, "", nil
+		})
+		if err != nil {
+			t.Errorf("%s waiting for %s webhook to be responsive: %v\n\n"+
+				"Troubleshooting steps:\n"+
+				"  1. Check webhook service exists: kubectl --context %s -n %s get svc %s\n"+
+				"  2. Check endpoint has addresses: kubectl --context %s -n %s get endpoints %s\n"+
+				"  3. Check controller pod is running: kubectl --context %s -n %s get pods\n"+
+				"  4. Check for certificate issues: kubectl --context %s get certificates -A\n\n"+
+				"Common causes:\n"+
+				"  - Controller manager pod not running or crashing\n"+
+				"  - cert-manager hasn't issued webhook certificate yet\n"+
+				"  - Service selector doesn't match pod labels",
+				PollErrorPrefix(err), wh.DisplayName, err,
+				context, wh.Namespace, wh.ServiceName,
+				context, wh.Namespace, wh.ServiceName,
+				context, wh.Namespace,
+				context)
+			continue
 		}
+
+		PrintToTTY("✅ %s webhook is ready (endpoint %s) - took %v\n",
+			wh.DisplayName, endpointIP, time.Since(startTime).Round(time.Second))
+		t.Logf("%s webhook is ready (endpoint %s)", wh.DisplayName, endpointIP)
 	}
 
 	PrintToTTY("\n=== Webhook readiness check complete ===\n\n")
 	t.Log("All webhook readiness checks completed")
 }
+
+// clusterPhaseSteps returns this file's tests in the order they must run.
+func clusterPhaseSteps() []e2eStep {
+	return []e2eStep{
+		{name: "ExternalCluster_01_Connectivity", run: TestExternalCluster_01_Connectivity},
+		{name: "ExternalCluster_01b_MCEBaselineStatus", run: TestExternalCluster_01b_MCEBaselineStatus},
+		{name: "ExternalCluster_02_EnsureMCEComponents", run: TestExternalCluster_02_EnsureMCEComponents},
+		{name: "KindCluster_01_ClusterReady", run: TestKindCluster_01_ClusterReady},
+		{name: "KindCluster_01b_NodeCountMatchesKindConfig", run: TestKindCluster_01b_NodeCountMatchesKindConfig},
+		{name: "KindCluster_02_ControllersInstalled", run: TestKindCluster_02_ControllersInstalled},
+		{name: "KindCluster_CAPINamespacesExists", run: TestKindCluster_CAPINamespacesExists},
+		{name: "KindCluster_CAPIControllerReady", run: TestKindCluster_CAPIControllerReady},
+		{name: "KindCluster_InfraControllersReady", run: TestKindCluster_InfraControllersReady},
+		{name: "KindCluster_ControllerImagesPinned", run: TestKindCluster_ControllerImagesPinned},
+		{name: "KindCluster_ControllerImagesUseMirror", run: TestKindCluster_ControllerImagesUseMirror},
+		{name: "KindCluster_WebhooksReady", run: TestKindCluster_WebhooksReady},
+	}
+}
+
+// TestKindCluster_ZZZ_RunPhase runs clusterPhaseSteps in order via runOrderedPhase.
+func TestKindCluster_ZZZ_RunPhase(t *testing.T) {
+	runOrderedPhase(t, clusterPhaseSteps())
+}
@@ -23,6 +23,8 @@ func TestInfrastructure_01_ValidateCredentials(t *testing.T) {
 		t.Fatalf("Configuration initialization failed: %s", *configError)
 	}
 
+	CheckToolsForPhase(t, "generate")
+
 	config := NewTestConfig()
 
 	PrintTestHeader(t, "TestInfrastructure_ValidateCredentials",
@@ -114,8 +116,18 @@ func TestInfrastructure_01_ValidateCredentials(t *testing.T) {
 
 // TestInfrastructure_GenerateResources tests generating ARO infrastructure resources
 func TestInfrastructure_GenerateResources(t *testing.T) {
+	TrackPhaseTiming(t)
 
-	config := NewTestConfig()
+	ForEachCluster(t, NewTestConfig(), generateResourcesForCluster)
+}
+
+// generateResourcesForCluster is TestInfrastructure_GenerateResources for a single workload cluster.
+func generateResourcesForCluster(t *testing.T, config *TestConfig) {
+	// Set KUBECONFIG for external cluster mode
+	if config.IsExternalCluster() {
+		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
+	}
+	RequireManagementClusterReachable(t, config)
 
 	if !DirExists(config.RepoDir) {
 		t.Skipf("Repository not cloned yet at %s", config.RepoDir)
@@ -215,48 +227,13 @@ func TestInfrastructure_GenerateResources(t *testing.T) {
 
 	t.Logf("Generating infrastructure resources for cluster '%s' (env: %s)", config.WorkloadClusterName, config.Environment)
 
-	// Set environment variables for the generation script
-	SetEnvVar(t, "DEPLOYMENT_ENV", config.Environment)
-	SetEnvVar(t, "USER", config.CAPIUser)
-	SetEnvVar(t, "WORKLOAD_CLUSTER_NAME", config.WorkloadClusterName)
-	SetEnvVar(t, config.RegionEnvVar, config.Region) // Provider-specific: REGION for ARO, AWS_REGION for ROSA
-	SetEnvVar(t, "CS_CLUSTER_NAME", config.ClusterNamePrefix)
-	SetEnvVar(t, "RESOURCEGROUPNAME", config.ResourceGroupName)
-	SetEnvVar(t, "OCP_VERSION", config.OCPVersion)
-	SetEnvVar(t, "OCP_VERSION_MP", config.OCPVersionMP)
-	// ROSA gen.sh reads OPENSHIFT_VERSION (not OCP_VERSION) for the cluster version.
-	// Set both so the test's configured version reaches the generation script.
-	SetEnvVar(t, "OPENSHIFT_VERSION", config.OCPVersion)
-	// Pass namespace as NAMESPACE env var for YAML generation script
-	// This namespace will be embedded in generated YAMLs for Azure resources
-	SetEnvVar(t, "NAMESPACE", config.WorkloadClusterNamespace)
-
-	if config.AzureSubscriptionName != "" {
-		SetEnvVar(t, "AZURE_SUBSCRIPTION_NAME", config.AzureSubscriptionName)
-	}
-
 	PrintToTTY("Workload cluster namespace: %s\n", config.WorkloadClusterNamespace)
 
-	// Change to repository directory for script execution
-	originalDir, err := os.Getwd()
-	if err != nil {
-		t.Fatalf("Failed to get current directory: %v", err)
-	}
-	defer func() {
-		if err := os.Chdir(originalDir); err != nil {
-			t.Logf("Warning: failed to change back to original directory: %v", err)
-		}
-	}()
-
-	if err := os.Chdir(config.RepoDir); err != nil {
-		t.Fatalf("Failed to change to repository directory: %v", err)
-	}
-
 	// Run the generation script
 	PrintToTTY("\n=== Generating infrastructure resources ===\n")
 	PrintToTTY("Running infrastructure generation script: %s %s\n", genScriptPath, config.GetOutputDirName())
 	t.Log("Running infrastructure generation script...")
-	output, err := RunCommand(t, "bash", genScriptPath, config.GetOutputDirName())
+	output, err := runGenerationScript(t, config, genScriptPath, config.GetOutputDirName())
 	if err != nil {
 		// On error, show output for debugging (may contain sensitive info, but needed for troubleshooting)
 		t.Errorf("Failed to generate infrastructure resources: %v\nOutput: %s", err, output)
@@ -428,11 +405,64 @@ func redactSecrets(content []byte) ([]byte, bool) {
 	return []byte(strings.Join(result, "---")), redacted
 }
 
+// runGenerationScript runs the generation script from the repository directory with the
+// environment variables it reads set from config, writing into outputDirName (relative to
+// RepoDir). It returns the script output, which may contain sensitive values.
+func runGenerationScript(t *testing.T, config *TestConfig, genScriptPath, outputDirName string) (string, error) {
+	t.Helper()
+
+	// Set environment variables for the generation script
+	SetEnvVar(t, EnvDeploymentEnv, config.Environment)
+	SetEnvVar(t, EnvOSUser, config.CAPIUser)
+	SetEnvVar(t, EnvWorkloadClusterName, config.WorkloadClusterName)
+	SetEnvVar(t, config.RegionEnvVar, config.Region) // Provider-specific: REGION for ARO, AWS_REGION for ROSA
+	SetEnvVar(t, EnvClusterNamePrefix, config.ClusterNamePrefix)
+	SetEnvVar(t, EnvResourceGroupName, config.ResourceGroupName)
+	SetEnvVar(t, EnvOCPVersion, config.OCPVersion)
+	SetEnvVar(t, EnvOCPVersionMP, config.OCPVersionMP)
+	// ROSA gen.sh reads OPENSHIFT_VERSION (not OCP_VERSION) for the cluster version.
+	// Set both so the test's configured version reaches the generation script.
+	SetEnvVar(t, EnvGenOpenShiftVersion, config.OCPVersion)
+	// Pass namespace as NAMESPACE env var for YAML generation script
+	// This namespace will be embedded in generated YAMLs for Azure resources
+	SetEnvVar(t, EnvGenNamespace, config.WorkloadClusterNamespace)
+
+	if config.AzureSubscriptionName != "" {
+		SetEnvVar(t, EnvAzureSubscriptionName, config.AzureSubscriptionName)
+	}
+	if config.MachineSKU != "" {
+		SetEnvVar(t, EnvMachineSKU, config.MachineSKU)
+	}
+
+	// Change to repository directory for script execution
+	originalDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current directory: %v", err)
+	}
+	defer func() {
+		if err := os.Chdir(originalDir); err != nil {
+			t.Logf("Warning: failed to change back to original directory: %v", err)
+		}
+	}()
+
+	if err := os.Chdir(config.RepoDir); err != nil {
+		t.Fatalf("Failed to change to repository directory: %v", err)
+	}
+
+	return RunCommand(t, "bash", genScriptPath, outputDirName)
+}
+
 // TestInfrastructure_VerifyCredentialsYAML verifies credentials.yaml exists and is valid
 // This test uses file-based detection for idempotency - it will work correctly
 // whether run in the same test invocation as GenerateResources or separately.
 func TestInfrastructure_VerifyGeneratedYAMLs(t *testing.T) {
-	config := NewTestConfig()
+	TrackPhaseTiming(t)
+
+	ForEachCluster(t, NewTestConfig(), verifyGeneratedYAMLsForCluster)
+}
+
+// verifyGeneratedYAMLsForCluster is TestInfrastructure_VerifyGeneratedYAMLs for a single workload cluster.
+func verifyGeneratedYAMLsForCluster(t *testing.T, config *TestConfig) {
 	outputDir := filepath.Join(config.RepoDir, config.GetOutputDirName())
 
 	if !DirExists(outputDir) {
@@ -476,3 +506,421 @@ func TestInfrastructure_VerifyGeneratedYAMLs(t *testing.T) {
 		})
 	}
 }
+
+// TestInfrastructure_VerifyCredentialSecretKeys verifies the generated credential secret contains
+// every required key with a non-empty, decodable value. A size check alone passes when the
+// generator emits a secret with one blank credential field, which only surfaces much later as
+// an authentication failure in the provider controller.
+func TestInfrastructure_VerifyCredentialSecretKeys(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+	outputDir := filepath.Join(config.RepoDir, config.GetOutputDirName())
+
+	if !DirExists(outputDir) {
+		t.Skipf("Output directory does not exist: %s", outputDir)
+	}
+
+	hasCredentials := false
+	for _, p := range config.InfraProviders {
+		if p.CredentialSecret != nil {
+			hasCredentials = true
+			break
+		}
+	}
+	if !hasCredentials {
+		t.Skip("No provider credential secrets to verify")
+	}
+
+	// Collect secrets from all generated files, keyed by name
+	secretsByName := make(map[string]SecretManifest)
+	secretFiles := make(map[string]string)
+	for _, filename := range config.GetExpectedFiles() {
+		filePath := filepath.Join(outputDir, filename)
+		if !FileExists(filePath) {
+			continue // reported by TestInfrastructure_VerifyGeneratedYAMLs
+		}
+		secrets, err := ParseSecretManifest(filePath)
+		if err != nil {
+			t.Errorf("Failed to parse %s: %v", filename, err)
+			continue
+		}
+		for _, secret := range secrets {
+			secretsByName[secret.Name] = secret
+			secretFiles[secret.Name] = filename
+		}
+	}
+
+	for _, provider := range config.InfraProviders {
+		if provider.CredentialSecret == nil {
+			continue
+		}
+		cred := provider.CredentialSecret
+		secretName := strings.ReplaceAll(cred.Name, "{WORKLOAD_CLUSTER_NAME}", config.WorkloadClusterName)
+
+		t.Run(provider.Name, func(t *testing.T) {
+			secret, ok := secretsByName[secretName]
+			if !ok {
+				t.Fatalf("Credential secret %q not found in generated files %v.\n\n"+
+					"To fix this:\n"+
+					"  1. Check that the generation script emits the %s secret\n"+
+					"  2. Regenerate: go test -v ./test -run TestInfrastructure_GenerateResources",
+					secretName, config.GetExpectedFiles(), secretName)
+			}
+
+			if problems := MissingSecretKeys(secret, cred.RequiredFields); len(problems) > 0 {
+				PrintToTTY("❌ Secret %s in %s has missing or empty keys: %s\n",
+					secretName, secretFiles[secretName], strings.Join(problems, ", "))
+				t.Fatalf("Credential secret %s in %s has missing or empty keys: %s\n\n"+
+					"To fix this:\n"+
+					"  1. Verify the matching credential environment variables are set and non-empty\n"+
+					"  2. Regenerate: go test -v ./test -run TestInfrastructure_GenerateResources",
+					secretName, secretFiles[secretName], strings.Join(problems, ", "))
+			}
+
+			PrintToTTY("✅ Secret %s in %s has all %d required keys\n",
+				secretName, secretFiles[secretName], len(cred.RequiredFields))
+			t.Logf("Credential secret %s contains all required keys: %v", secretName, cred.RequiredFields)
+		})
+	}
+}
+
+// TestInfrastructure_VerifyManifestReferences verifies every identity and secret reference in the
+// generated manifests resolves to an object defined in one of them. A name mismatch between the
+// cluster YAML and the credentials file is otherwise only visible as a "secret not found"
+// reconcile loop after the CRs are applied.
+func TestInfrastructure_VerifyManifestReferences(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+	outputDir := filepath.Join(config.RepoDir, config.GetOutputDirName())
+
+	if !DirExists(outputDir) {
+		t.Skipf("Output directory does not exist: %s", outputDir)
+	}
+
+	expectedFiles := config.GetExpectedFiles()
+	for _, filename := range expectedFiles {
+		if !FileExists(filepath.Join(outputDir, filename)) {
+			t.Skipf("%s not generated yet, skipping reference check (reported by TestInfrastructure_VerifyGeneratedYAMLs)", filename)
+		}
+	}
+
+	if err := CheckManifestReferences(outputDir, expectedFiles); err != nil {
+		PrintToTTY("❌ Generated manifests have unresolved references\n")
+		t.Fatalf("Generated manifests in %s have unresolved references: %v\n\n"+
+			"Each identityRef/secretRef must name an object defined in %v.\n\n"+
+			"To fix this:\n"+
+			"  1. Check that the generation script uses the same names for the identity and its secret\n"+
+			"  2. Regenerate: rm -rf %s && go test -v ./test -run TestInfrastructure_GenerateResources",
+			outputDir, err, expectedFiles, outputDir)
+	}
+
+	PrintToTTY("✅ All identity and secret references in %v resolve\n", expectedFiles)
+	t.Logf("All identity and secret references in %v resolve", expectedFiles)
+}
+
+// TestInfrastructure_VerifyManifestNamespace verifies every namespaced object in the generated
+// cluster YAML targets the workload cluster namespace. The files are applied without -n and every
+// later phase looks up resources with -n WorkloadClusterNamespace, so a generator that omits or
+// hardcodes a namespace otherwise surfaces as "not found" waits in phase 05.
+func TestInfrastructure_VerifyManifestNamespace(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	ForEachCluster(t, NewTestConfig(), verifyManifestNamespaceForCluster)
+}
+
+// verifyManifestNamespaceForCluster is TestInfrastructure_VerifyManifestNamespace for a single workload cluster.
+func verifyManifestNamespaceForCluster(t *testing.T, config *TestConfig) {
+	clusterYAMLPath := filepath.Join(config.RepoDir, config.GetOutputDirName(), config.ClusterYAML)
+	if !FileExists(clusterYAMLPath) {
+		t.Skipf("%s not generated yet, skipping namespace check (reported by TestInfrastructure_VerifyGeneratedYAMLs)", config.ClusterYAML)
+	}
+
+	if err := CheckManifestNamespace(clusterYAMLPath, config.WorkloadClusterNamespace); err != nil {
+		PrintToTTY("❌ %s does not target namespace %s\n", config.ClusterYAML, config.WorkloadClusterNamespace)
+		t.Fatalf("%s: %v\n\n"+
+			"Every namespaced object must set metadata.namespace to WORKLOAD_CLUSTER_NAMESPACE (%s).\n\n"+
+			"To fix this:\n"+
+			"  1. Check that the generation script writes $NAMESPACE into every object\n"+
+			"  2. Regenerate: rm -rf %s && go test -v ./test -run TestInfrastructure_GenerateResources",
+			config.ClusterYAML, err, config.WorkloadClusterNamespace, filepath.Dir(clusterYAMLPath))
+	}
+
+	PrintToTTY("✅ All objects in %s target namespace %s\n", config.ClusterYAML, config.WorkloadClusterNamespace)
+	t.Logf("All namespaced objects in %s target namespace %s", config.ClusterYAML, config.WorkloadClusterNamespace)
+}
+
+// TestInfrastructure_VerifyManifestSchema validates the generated manifests against the Kubernetes
+// and CAPI/CAPZ/ASO CRD schemas with kubeconform. ValidateYAMLFile only checks syntax, so API-version
+// drift (a removed or renamed field) otherwise surfaces as a server-side rejection during apply.
+func TestInfrastructure_VerifyManifestSchema(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+	outputDir := filepath.Join(config.RepoDir, config.GetOutputDirName())
+
+	if !DirExists(outputDir) {
+		t.Skipf("Output directory does not exist: %s", outputDir)
+	}
+
+	if !CommandExists("kubeconform") {
+		t.Skip("kubeconform not installed, skipping schema validation (install: go install github.com/yannh/kubeconform/cmd/kubeconform@latest)")
+	}
+
+	for _, filename := range config.GetExpectedFiles() {
+		t.Run(filename, func(t *testing.T) {
+			filePath := filepath.Join(outputDir, filename)
+			if !FileExists(filePath) {
+				t.Skipf("%s not generated yet (reported by TestInfrastructure_VerifyGeneratedYAMLs)", filename)
+			}
+
+			violations, summary, err := ValidateManifestSchema(t, filePath, config.ManifestSchemaLocation)
+			if err != nil {
+				t.Fatalf("Schema validation of %s could not run: %v\n\n"+
+					"To fix this:\n"+
+					"  1. Check network access to the schema location: %s\n"+
+					"  2. Or point KUBECONFORM_SCHEMA_LOCATION at a local copy of the CRD schemas",
+					filename, err, config.ManifestSchemaLocation)
+			}
+
+			if len(violations) > 0 {
+				PrintToTTY("❌ %s: %d schema violation(s)\n", filename, len(violations))
+				for _, v := range violations {
+					PrintToTTY("  - %s\n", v)
+				}
+				t.Fatalf("%s does not match the CRD schemas:\n  %s\n\n"+
+					"This usually means the generation script emits a field or API version the installed\n"+
+					"CRDs no longer accept. Check the cluster-api-installer branch against the controller versions.",
+					filename, strings.Join(violations, "\n  "))
+			}
+
+			if summary.Skipped > 0 {
+				PrintToTTY("⚠️  %s: %d resource(s) skipped (no published schema)\n", filename, summary.Skipped)
+			}
+			PrintToTTY("✅ %s matches schemas (%d valid)\n", filename, summary.Valid)
+			t.Logf("%s: %d valid, %d skipped (no schema)", filename, summary.Valid, summary.Skipped)
+		})
+	}
+}
+
+// TestInfrastructure_DryRunApply submits each generated file to the management cluster with
+// `kubectl apply --dry-run=server`. This runs the CAPI/CAPZ admission webhooks and server-side
+// validation without creating anything, so rejections (immutable fields, bad references,
+// unknown kinds) surface here rather than midway through the mutating apply in phase 05.
+func TestInfrastructure_DryRunApply(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+	outputDir := filepath.Join(config.RepoDir, config.GetOutputDirName())
+
+	if !DirExists(outputDir) {
+		t.Skipf("Output directory does not exist: %s", outputDir)
+	}
+
+	if config.IsExternalCluster() {
+		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
+	}
+	context := config.GetKubeContext()
+
+	if _, err := RunCommandQuiet(t, "kubectl", "--context", context, "get", "nodes", "--request-timeout=10s"); err != nil {
+		t.Skipf("Management cluster not reachable (context %s), skipping server-side dry-run: %v", context, err)
+	}
+
+	PrintToTTY("\n=== Server-side dry-run apply ===\n")
+	PrintToTTY("Context: %s\n\n", context)
+
+	for _, filename := range config.GetExpectedFiles() {
+		t.Run(filename, func(t *testing.T) {
+			filePath := filepath.Join(outputDir, filename)
+			if !FileExists(filePath) {
+				t.Skipf("%s not generated yet (reported by TestInfrastructure_VerifyGeneratedYAMLs)", filename)
+			}
+
+			result, err := DryRunApplyFile(t, context, filePath)
+			if err != nil {
+				t.Fatalf("Server-side dry-run of %s could not run: %v", filename, err)
+			}
+
+			if len(result.Rejected) > 0 {
+				PrintToTTY("❌ %s: API server rejected %d object(s)\n", filename, len(result.Rejected))
+				for _, r := range result.Rejected {
+					PrintToTTY("  - %s\n", r)
+				}
+				t.Fatalf("API server rejected objects in %s:\n  %s\n\n"+
+					"To fix this:\n"+
+					"  1. Check the webhook message above for the offending field\n"+
+					"  2. Verify the cluster-api-installer branch matches the deployed controller versions\n"+
+					"  3. Regenerate: rm -rf %s && go test -v ./test -run TestInfrastructure_GenerateResources",
+					filename, strings.Join(result.Rejected, "\n  "), outputDir)
+			}
+
+			if len(result.PendingNamespaces) > 0 {
+				PrintToTTY("⏭️  %s: objects in namespace(s) %v not checked (created in phase 05)\n",
+					filename, result.PendingNamespaces)
+				t.Logf("Namespace(s) %v do not exist yet; their objects were not dry-run", result.PendingNamespaces)
+				if len(result.Accepted) == 0 {
+					t.Skipf("All objects in %s target namespace(s) %v, which are created in phase 05", filename, result.PendingNamespaces)
+				}
+			}
+
+			PrintToTTY("✅ %s: API server accepted %d object(s)\n", filename, len(result.Accepted))
+			t.Logf("%s: API server accepted %v", filename, result.Accepted)
+		})
+	}
+}
+
+// TestInfrastructure_ShowDrift reports, for re-runs, whether re-applying the generated manifests
+// would change what is live in the management cluster. Drift usually means a resource was edited
+// in-cluster and the next apply in phase 05 would revert it. Drift is informational; only a
+// failing kubectl diff fails the test.
+func TestInfrastructure_ShowDrift(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+	outputDir := filepath.Join(config.RepoDir, config.GetOutputDirName())
+
+	if !DirExists(outputDir) {
+		t.Skipf("Output directory does not exist: %s", outputDir)
+	}
+
+	if config.IsExternalCluster() {
+		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
+	}
+	context := config.GetKubeContext()
+
+	if _, err := RunCommandQuiet(t, "kubectl", "--context", context, "get", "namespace",
+		config.WorkloadClusterNamespace, "--request-timeout=10s"); err != nil {
+		t.Skipf("Namespace %s not found on context %s, nothing deployed yet to compare against",
+			config.WorkloadClusterNamespace, context)
+	}
+
+	PrintToTTY("\n=== Drift between generated manifests and the cluster ===\n")
+	PrintToTTY("Context: %s\n\n", context)
+
+	for _, filename := range config.GetExpectedFiles() {
+		t.Run(filename, func(t *testing.T) {
+			filePath := filepath.Join(outputDir, filename)
+			if !FileExists(filePath) {
+				t.Skipf("%s not generated yet (reported by TestInfrastructure_VerifyGeneratedYAMLs)", filename)
+			}
+
+			changed, diff, err := DiffManifests(t, context, filePath)
+			if err != nil {
+				PrintToTTY("❌ %s: could not diff against the cluster\n", filename)
+				t.Fatalf("Failed to diff %s against the cluster: %v", filename, err)
+			}
+
+			if !changed {
+				PrintToTTY("✅ %s: no drift, re-applying would not change anything\n", filename)
+				t.Logf("%s matches the live objects", filename)
+				return
+			}
+
+			PrintToTTY("⚠️  %s: re-applying would change live objects\n%s\n\n", filename, diff)
+			t.Logf("Drift detected for %s (re-apply in phase 05 will revert in-cluster edits):\n%s", filename, diff)
+		})
+	}
+}
+
+// generationEnvironments are the DEPLOYMENT_ENV values TestInfrastructure_GenerateResourcesEnvironments
+// generates for, whatever the configured environment is.
+var generationEnvironments = []string{"stage", "prod"}
+
+// TestInfrastructure_GenerateResourcesEnvironments runs the generation script once per deployment
+// environment (stage and prod) into a scratch output directory and checks the output reflects
+// that environment. Runs use the configured environment (stage by default), so a bug in the
+// generator's prod branch would otherwise only show up in a prod deployment.
+func TestInfrastructure_GenerateResourcesEnvironments(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+	if !DirExists(config.RepoDir) {
+		t.Skipf("Repository not cloned yet at %s", config.RepoDir)
+	}
+	genScriptPath := filepath.Join(config.RepoDir, config.GenScriptPath)
+	if !FileExists(genScriptPath) {
+		t.Skipf("Generation script not found: %s", genScriptPath)
+	}
+
+	for _, env := range generationEnvironments {
+		t.Run(env, func(t *testing.T) {
+			envConfig := *config
+			envConfig.Environment = env
+			generateResourcesForEnvironment(t, &envConfig, genScriptPath)
+		})
+	}
+}
+
+// generateResourcesForEnvironment is one environment of TestInfrastructure_GenerateResourcesEnvironments.
+// It writes to a scratch directory that is removed afterwards, so the real output is never touched.
+func generateResourcesForEnvironment(t *testing.T, config *TestConfig, genScriptPath string) {
+	if err := ValidateDomainPrefix(config.CAPIUser, config.Environment); err != nil {
+		t.Fatalf("Domain prefix validation failed for %s: %v", config.Environment, err)
+	}
+	if !strings.HasSuffix(config.GetOutputDirName(), "-"+config.Environment) {
+		t.Errorf("Output directory %s does not name environment %s", config.GetOutputDirName(), config.Environment)
+	}
+
+	outputDirName := config.GetOutputDirName() + "-envcheck"
+	outputDir := filepath.Join(config.RepoDir, outputDirName)
+	t.Cleanup(func() {
+		if err := os.RemoveAll(outputDir); err != nil {
+			t.Logf("Warning: failed to remove %s: %v", outputDir, err)
+		}
+	})
+
+	PrintToTTY("\n=== Generating resources for DEPLOYMENT_ENV=%s ===\n", config.Environment)
+	output, err := runGenerationScript(t, config, genScriptPath, outputDirName)
+	if err != nil {
+		PrintToTTY("❌ Generation failed for DEPLOYMENT_ENV=%s\n", config.Environment)
+		t.Fatalf("Generation failed for DEPLOYMENT_ENV=%s: %v\nOutput: %s", config.Environment, err, output)
+	}
+
+	for _, file := range config.GetExpectedFiles() {
+		if !FileExists(filepath.Join(outputDir, file)) {
+			t.Errorf("DEPLOYMENT_ENV=%s: %s was not generated", config.Environment, file)
+		}
+	}
+	if t.Failed() {
+		return
+	}
+
+	clusterYAMLPath := filepath.Join(outputDir, config.ClusterYAML)
+	if err := CheckManifestNamespace(clusterYAMLPath, config.WorkloadClusterNamespace); err != nil {
+		t.Errorf("DEPLOYMENT_ENV=%s: %s: %v", config.Environment, config.ClusterYAML, err)
+	}
+
+	// ARO derives the cluster's domain prefix from USER and DEPLOYMENT_ENV
+	if config.HasProvider("aro") {
+		if err := CheckGeneratedEnvironment(clusterYAMLPath, config.CAPIUser, config.Environment); err != nil {
+			t.Errorf("DEPLOYMENT_ENV=%s: %v", config.Environment, err)
+		}
+	}
+
+	if !t.Failed() {
+		PrintToTTY("✅ DEPLOYMENT_ENV=%s generated %v\n", config.Environment, config.GetExpectedFiles())
+		t.Logf("DEPLOYMENT_ENV=%s generated %v", config.Environment, config.GetExpectedFiles())
+	}
+}
+
+// generateYAMLsPhaseSteps returns this file's tests in the order they must run.
+func generateYAMLsPhaseSteps() []e2eStep {
+	return []e2eStep{
+		{name: "01_ValidateCredentials", run: TestInfrastructure_01_ValidateCredentials},
+		{name: "GenerateResources", run: TestInfrastructure_GenerateResources},
+		{name: "VerifyGeneratedYAMLs", run: TestInfrastructure_VerifyGeneratedYAMLs},
+		{name: "VerifyCredentialSecretKeys", run: TestInfrastructure_VerifyCredentialSecretKeys},
+		{name: "VerifyManifestReferences", run: TestInfrastructure_VerifyManifestReferences},
+		{name: "VerifyManifestNamespace", run: TestInfrastructure_VerifyManifestNamespace},
+		{name: "VerifyManifestSchema", run: TestInfrastructure_VerifyManifestSchema},
+		{name: "DryRunApply", run: TestInfrastructure_DryRunApply},
+		{name: "ShowDrift", run: TestInfrastructure_ShowDrift},
+		{name: "GenerateResourcesEnvironments", run: TestInfrastructure_GenerateResourcesEnvironments},
+	}
+}
+
+// TestInfrastructure_ZZZ_RunPhase runs generateYAMLsPhaseSteps in order via runOrderedPhase.
+func TestInfrastructure_ZZZ_RunPhase(t *testing.T) {
+	runOrderedPhase(t, generateYAMLsPhaseSteps())
+}
@@ -2,8 +2,11 @@ package test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -19,6 +22,8 @@ func TestDeployment_00_CreateNamespace(t *testing.T) {
 		t.Fatalf("Configuration initialization failed: %s", *configError)
 	}
 
+	CheckToolsForPhase(t, "deploy")
+
 	config := NewTestConfig()
 
 	// Set KUBECONFIG for external cluster mode
@@ -35,22 +40,17 @@ func TestDeployment_00_CreateNamespace(t *testing.T) {
 	PrintToTTY("Namespace: %s\n", config.WorkloadClusterNamespace)
 	PrintToTTY("Context: %s\n\n", context)
 
-	// Check if namespace already exists
-	_, err := RunCommandQuiet(t, "kubectl", "--context", context, "get", "namespace", config.WorkloadClusterNamespace)
-	if err == nil {
+	created, err := EnsureNamespace(t, context, config.WorkloadClusterNamespace)
+	if err != nil {
+		PrintToTTY("❌ Failed to create namespace: %v\n", err)
+		t.Fatalf("Failed to create namespace '%s': %v", config.WorkloadClusterNamespace, err)
+	}
+	if !created {
 		PrintToTTY("✅ Namespace '%s' already exists\n\n", config.WorkloadClusterNamespace)
 		t.Logf("Namespace '%s' already exists", config.WorkloadClusterNamespace)
 		return
 	}
 
-	// Create the namespace
-	PrintToTTY("Creating namespace '%s'...\n", config.WorkloadClusterNamespace)
-	output, err := RunCommand(t, "kubectl", "--context", context, "create", "namespace", config.WorkloadClusterNamespace)
-	if err != nil {
-		PrintToTTY("❌ Failed to create namespace: %v\n", err)
-		t.Fatalf("Failed to create namespace '%s': %v\nOutput: %s", config.WorkloadClusterNamespace, err, output)
-	}
-
 	PrintToTTY("✅ Namespace '%s' created successfully\n\n", config.WorkloadClusterNamespace)
 	t.Logf("Created namespace: %s", config.WorkloadClusterNamespace)
 
@@ -72,6 +72,7 @@ func TestDeployment_00_CreateNamespace(t *testing.T) {
 // This fail-fast check prevents deploying new clusters alongside stale resources from previous
 // configurations (e.g., when CAPI_USER was changed without cleanup).
 func TestDeployment_01_CheckExistingClusters(t *testing.T) {
+	TrackPhaseTiming(t)
 
 	config := NewTestConfig()
 
@@ -84,10 +85,11 @@ func TestDeployment_01_CheckExistingClusters(t *testing.T) {
 
 	PrintToTTY("\n=== Checking for existing Cluster resources ===\n")
 	PrintToTTY("Namespace: %s\n", config.WorkloadClusterNamespace)
-	PrintToTTY("Expected cluster name: %s\n\n", config.WorkloadClusterName)
+	expectedNames := config.ClusterSet().Names()
+	PrintToTTY("Expected cluster name: %s\n\n", strings.Join(expectedNames, ", "))
 
 	// Check for existing clusters that don't match current config
-	mismatched, err := CheckForMismatchedClusters(t, context, config.WorkloadClusterNamespace, config.WorkloadClusterName)
+	mismatched, err := CheckForMismatchedClusters(t, context, config.WorkloadClusterNamespace, expectedNames...)
 	if err != nil {
 		// Non-fatal: log warning and continue if check fails
 		// This allows tests to proceed on clusters without CAPI installed
@@ -97,15 +99,17 @@ func TestDeployment_01_CheckExistingClusters(t *testing.T) {
 		return
 	}
 
-	// Also get all existing clusters for informational purposes
-	existing, _ := GetExistingClusterNames(t, context, config.WorkloadClusterNamespace)
+	// Also get the clusters in every namespace, since earlier runs used their own namespace,
+	// to list them and apply EXISTING_CLUSTER_POLICY
+	existing, existErr := CheckNoExistingWorkloadClusters(t, context)
 	if len(existing) > 0 {
 		PrintToTTY("Found %d existing Cluster resource(s):\n", len(existing))
-		for _, name := range existing {
-			if name == config.WorkloadClusterName {
-				PrintToTTY("  ✅ %s (matches current config)\n", name)
+		for _, entry := range existing {
+			namespace, name, _ := strings.Cut(entry, "/")
+			if namespace == config.WorkloadClusterNamespace && slices.Contains(expectedNames, name) {
+				PrintToTTY("  ✅ %s (matches current config)\n", entry)
 			} else {
-				PrintToTTY("  ❌ %s (does NOT match current config)\n", name)
+				PrintToTTY("  ❌ %s (does NOT match current config)\n", entry)
 			}
 		}
 		PrintToTTY("\n")
@@ -115,12 +119,24 @@ func TestDeployment_01_CheckExistingClusters(t *testing.T) {
 
 	// Fail if there are mismatched clusters
 	if len(mismatched) > 0 {
-		errorMsg := FormatMismatchedClustersError(mismatched, config.WorkloadClusterName, config.WorkloadClusterNamespace)
+		errorMsg := FormatMismatchedClustersError(mismatched, strings.Join(expectedNames, ", "), config.WorkloadClusterNamespace)
 		PrintToTTY("%s", errorMsg)
 
 		t.Fatalf("Mismatched Cluster CRs found. Clean up existing clusters before deploying.\n"+
 			"Found %d cluster(s) not matching expected name '%s': %v",
-			len(mismatched), config.WorkloadClusterName, mismatched)
+			len(mismatched), strings.Join(expectedNames, ", "), mismatched)
+	}
+
+	if errors.Is(existErr, ErrWorkloadClustersExist) {
+		msg := FormatExistingClustersMessage(existing)
+		switch config.ExistingClusterPolicy {
+		case ExistingClusterFail:
+			PrintToTTY("❌ %s\n", msg)
+			t.Fatalf("%v (EXISTING_CLUSTER_POLICY=fail)\n\n%s", existErr, msg)
+		case ExistingClusterWarn:
+			PrintToTTY("⚠️  %s\n", msg)
+			t.Logf("Warning: %v (EXISTING_CLUSTER_POLICY=warn)", existErr)
+		}
 	}
 
 	PrintToTTY("✅ All existing clusters match current configuration\n\n")
@@ -128,13 +144,18 @@ func TestDeployment_01_CheckExistingClusters(t *testing.T) {
 
 // TestDeployment_ApplyResources tests applying generated resources to the cluster
 func TestDeployment_ApplyResources(t *testing.T) {
+	TrackPhaseTiming(t)
 
-	config := NewTestConfig()
+	ForEachCluster(t, NewTestConfig(), applyResourcesForCluster)
+}
 
+// applyResourcesForCluster is TestDeployment_ApplyResources for a single workload cluster.
+func applyResourcesForCluster(t *testing.T, config *TestConfig) {
 	// Set KUBECONFIG for external cluster mode
 	if config.IsExternalCluster() {
 		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
 	}
+	RequireManagementClusterReachable(t, config)
 
 	outputDir := filepath.Join(config.RepoDir, config.GetOutputDirName())
 
@@ -145,8 +166,11 @@ func TestDeployment_ApplyResources(t *testing.T) {
 
 	PrintToTTY("\n=== Applying Kubernetes resources ===\n")
 
-	// Get files to apply (provider-specific YAML files)
-	expectedFiles := config.GetExpectedFiles()
+	// Get files to apply (provider-specific YAML files, credentials before the cluster)
+	expectedFiles, err := config.GetApplyFiles()
+	if err != nil {
+		t.Fatalf("Cannot order files to apply: %v", err)
+	}
 
 	// Set kubectl context
 	context := config.GetKubeContext()
@@ -157,6 +181,19 @@ func TestDeployment_ApplyResources(t *testing.T) {
 		t.Fatalf("Cluster health check failed: %v", err)
 	}
 
+	// The namespaced resources in the generated files fail to apply if the namespace is missing,
+	// e.g. when this test runs on its own against a fresh management cluster.
+	if _, err := EnsureNamespace(t, context, config.WorkloadClusterNamespace); err != nil {
+		PrintToTTY("❌ Failed to ensure namespace %s: %v\n", config.WorkloadClusterNamespace, err)
+		t.Fatalf("Failed to ensure namespace '%s' exists: %v", config.WorkloadClusterNamespace, err)
+	}
+	waitForRequiredCRDs(t, config, context)
+
+	if config.KustomizeDir != "" {
+		applyKustomization(t, config, context, outputDir)
+		return
+	}
+
 	for _, file := range expectedFiles {
 		filePath := filepath.Join(outputDir, file)
 		if !FileExists(filePath) {
@@ -179,17 +216,91 @@ func TestDeployment_ApplyResources(t *testing.T) {
 	PrintToTTY("\n=== Resource application complete ===\n\n")
 }
 
+// waitForRequiredCRDs fails the test unless the CRDs the generated files use are established.
+// On a freshly deployed management cluster the apply can otherwise race CRD registration and
+// fail with "no matches for kind AROControlPlane".
+func waitForRequiredCRDs(t *testing.T, config *TestConfig, context string) {
+	t.Helper()
+
+	crds := config.AllRequiredCRDs()
+	PrintToTTY("Waiting for %d CRDs to be established...\n", len(crds))
+	if err := WaitForCRDsEstablished(t, context, crds, DefaultCRDEstablishedTimeout); err != nil {
+		PrintToTTY("❌ %v\n\n", err)
+		t.Fatalf("%v\n\n"+
+			"The controllers that install these CRDs are not deployed or not ready.\n"+
+			"To fix this:\n"+
+			"  1. Check the controllers: go test -v ./test -run 'TestKindCluster_CAPIControllerReady|TestKindCluster_InfraControllersReady'\n"+
+			"  2. List the installed CRDs: kubectl --context %s get crd | grep cluster.x-k8s.io",
+			err, context)
+	}
+	PrintToTTY("✅ All %d CRDs established\n\n", len(crds))
+}
+
+// applyKustomization applies the KUSTOMIZE_DIR overlay in place of the generated files: it
+// checks the kustomization builds, saves the rendered manifest next to the generated files
+// (KustomizeBuildFileName) for later phases, applies it with `kubectl apply -k`, and confirms
+// the MachinePool replicas the overlay sets are what the cluster holds.
+func applyKustomization(t *testing.T, config *TestConfig, context, outputDir string) {
+	t.Helper()
+
+	PrintToTTY("Kustomization: %s (replaces the generated file list)\n\n", config.KustomizeDir)
+	t.Logf("Applying kustomization %s instead of %v", config.KustomizeDir, config.GetExpectedFiles())
+
+	rendered, err := BuildKustomization(t, config.KustomizeDir)
+	if err != nil {
+		PrintToTTY("❌ Kustomization does not build: %v\n\n", err)
+		t.Fatalf("%v\n\n"+
+			"To debug:\n"+
+			"  kubectl kustomize %s\n\n"+
+			"The overlay's bases must point at the generated files in %s.",
+			err, config.KustomizeDir, outputDir)
+	}
+
+	buildPath := filepath.Join(outputDir, KustomizeBuildFileName)
+	if err := os.WriteFile(buildPath, []byte(rendered+"\n"), 0600); err != nil {
+		t.Logf("Warning: failed to save rendered kustomization to %s: %v", buildPath, err)
+	} else {
+		PrintToTTY("📄 Rendered kustomization: %s\n", buildPath)
+	}
+
+	if err := ApplyKustomizationWithRetry(t, context, config.KustomizeDir, DefaultApplyMaxRetries); err != nil {
+		PrintToTTY("❌ Failed to apply kustomization %s: %v\n\n", config.KustomizeDir, err)
+		t.Fatalf("Failed to apply kustomization %s: %v", config.KustomizeDir, err)
+	}
+
+	replicas, err := ParseMachinePoolReplicas([]byte(rendered))
+	if err != nil {
+		t.Logf("Warning: cannot read MachinePool replicas from the rendered kustomization: %v", err)
+	} else if len(replicas) > 0 {
+		if err := CheckMachinePoolReplicas(t, context, config.WorkloadClusterNamespace, replicas); err != nil {
+			PrintToTTY("❌ %v\n\n", err)
+			t.Errorf("%v", err)
+			return
+		}
+		PrintToTTY("✅ MachinePool replicas match the kustomization\n")
+	}
+
+	PrintToTTY("✅ Kustomization %s applied successfully\n\n", config.KustomizeDir)
+	t.Logf("Kustomization %s applied successfully", config.KustomizeDir)
+}
+
 // TestDeployment_ApplyCredentialsYAML tests applying credentials.yaml to the cluster
 // TestDeployment_ApplyClusterYAMLs tests applying all cluster YAML files in order.
 // This applies all files returned by GetExpectedFiles() which is provider-aware
 // (ARO: credentials.yaml, aro.yaml | ROSA: secrets.yaml, is.yaml, rosa.yaml).
 func TestDeployment_ApplyClusterYAMLs(t *testing.T) {
-	config := NewTestConfig()
+	TrackPhaseTiming(t)
 
+	ForEachCluster(t, NewTestConfig(), applyClusterYAMLsForCluster)
+}
+
+// applyClusterYAMLsForCluster is TestDeployment_ApplyClusterYAMLs for a single workload cluster.
+func applyClusterYAMLsForCluster(t *testing.T, config *TestConfig) {
 	// Set KUBECONFIG for external cluster mode
 	if config.IsExternalCluster() {
 		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
 	}
+	RequireManagementClusterReachable(t, config)
 
 	outputDir := filepath.Join(config.RepoDir, config.GetOutputDirName())
 
@@ -206,8 +317,24 @@ func TestDeployment_ApplyClusterYAMLs(t *testing.T) {
 		t.Fatalf("Cluster health check failed: %v", err)
 	}
 
-	// Get all expected files for this provider (order matters!)
-	expectedFiles := config.GetExpectedFiles()
+	// The namespaced resources in the generated files fail to apply if the namespace is missing,
+	// e.g. when this test runs on its own against a fresh management cluster.
+	if _, err := EnsureNamespace(t, context, config.WorkloadClusterNamespace); err != nil {
+		PrintToTTY("❌ Failed to ensure namespace %s: %v\n", config.WorkloadClusterNamespace, err)
+		t.Fatalf("Failed to ensure namespace '%s' exists: %v", config.WorkloadClusterNamespace, err)
+	}
+	waitForRequiredCRDs(t, config, context)
+
+	if config.KustomizeDir != "" {
+		applyKustomization(t, config, context, outputDir)
+		return
+	}
+
+	// Get all expected files for this provider, sorted by the provider's ApplyOrder
+	expectedFiles, err := config.GetApplyFiles()
+	if err != nil {
+		t.Fatalf("Cannot order files to apply: %v", err)
+	}
 
 	PrintToTTY("\n=== Applying Cluster YAML Files ===\n")
 	PrintToTTY("Provider: %s\n", config.InfraProviderName)
@@ -258,6 +385,8 @@ func TestDeployment_ApplyClusterYAMLs(t *testing.T) {
 //
 // Non-fatal: failures are logged as warnings since tagging is for cleanup convenience only.
 func TestDeployment_TagAzureResources(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	if config.InfraProviderName != "aro" {
@@ -285,25 +414,20 @@ func TestDeployment_TagAzureResources(t *testing.T) {
 		rgPollInterval = 15 * time.Second
 	}
 	rgStart := time.Now()
-	rgExists := false
 
 	PrintToTTY("Waiting for resource group %s to be created by ASO...\n", config.ResourceGroupName)
-	for time.Since(rgStart) < rgTimeout {
-		_, err := RunCommandQuiet(t, "az", "group", "show", "--name", config.ResourceGroupName)
-		if err == nil {
-			rgExists = true
-			PrintToTTY("✅ Resource group %s exists (waited %v)\n", config.ResourceGroupName, time.Since(rgStart).Round(time.Second))
-			break
+	err = PollUntil(RunContext(), t, rgTimeout, rgPollInterval, func() (bool, string, error) {
+		if _, err := RunCommandQuiet(t, "az", "group", "show", "--name", config.ResourceGroupName); err != nil {
+			return false, "resource group not yet created", nil
 		}
-		PrintToTTY("⏳ Resource group not yet created (elapsed %v)\n", time.Since(rgStart).Round(time.Second))
-		time.Sleep(rgPollInterval)
-	}
-
-	if !rgExists {
+		return true, "", nil
+	})
+	if err != nil {
 		t.Logf("Warning: resource group %s not created within %v, skipping tagging", config.ResourceGroupName, rgTimeout)
 		PrintToTTY("⚠️  Resource group not created within %v, skipping tagging\n\n", rgTimeout)
 		return
 	}
+	PrintToTTY("✅ Resource group %s exists (waited %v)\n", config.ResourceGroupName, time.Since(rgStart).Round(time.Second))
 
 	PrintToTTY("Tagging resource group %s...\n", config.ResourceGroupName)
 	if err := TagAzureResourceGroup(t, config); err != nil {
@@ -315,6 +439,65 @@ func TestDeployment_TagAzureResources(t *testing.T) {
 	PrintToTTY("✅ Azure resource tagging completed\n\n")
 }
 
+// TestDeployment_VerifyIdentityCreated confirms the Azure AD application and service principal
+// for the cluster exist after apply, so a failed identity creation for the workload identity
+// flow is caught at deploy time rather than when cleanup goes looking for it. Identities are
+// matched by the CS_CLUSTER_NAME display-name prefix. Only EXPECT_AZURE_IDENTITY=true waits
+// for them and fails when they are missing; otherwise the test reports what it finds.
+func TestDeployment_VerifyIdentityCreated(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+
+	if config.InfraProviderName != "aro" {
+		t.Skipf("AD identity verification only applies to ARO provider")
+	}
+
+	if err := EnsureAzureCliLogin(t); err != nil {
+		t.Skipf("Azure CLI auth unavailable: %v", err)
+	}
+
+	prefix := config.ClusterNamePrefix
+	PrintToTTY("\n=== Verifying Azure AD identity for prefix '%s' ===\n", prefix)
+
+	var identity ADIdentity
+	var err error
+	if config.ExpectAzureIdentity {
+		PrintToTTY("Waiting up to %v for the AD application and service principal (EXPECT_AZURE_IDENTITY=true)...\n", DefaultADIdentityTimeout)
+		identity, err = WaitForADIdentity(t, ExecAzureClient{}, prefix, DefaultADIdentityTimeout, DefaultADIdentityPollInterval)
+	} else {
+		identity, err = FindADIdentity(t, ExecAzureClient{}, prefix)
+	}
+
+	for _, app := range identity.Applications {
+		PrintToTTY("  📄 AD Application: %s (%s)\n", app.Name, app.ID)
+	}
+	for _, sp := range identity.ServicePrincipals {
+		PrintToTTY("  📄 Service Principal: %s (%s)\n", sp.Name, sp.ID)
+	}
+
+	switch {
+	case err != nil && config.ExpectAzureIdentity:
+		PrintToTTY("❌ %v\n\n", err)
+		t.Errorf("%v\n\n"+
+			"  To fix this:\n"+
+			"    1. Check the CAPZ/ASO controller logs for identity errors: kubectl --context %s -n %s logs deploy/azureserviceoperator-controller-manager\n"+
+			"    2. Confirm the service principal running the tests may create applications (Application.ReadWrite.OwnedBy)\n"+
+			"    3. If this cluster does not use workload identity, unset EXPECT_AZURE_IDENTITY",
+			err, config.GetKubeContext(), config.CAPZNamespace)
+	case err != nil:
+		PrintToTTY("⚠️  Could not list AD identities: %v\n\n", err)
+		t.Logf("Warning: could not list AD identities for prefix '%s': %v", prefix, err)
+	case identity.Created():
+		PrintToTTY("✅ AD application and service principal exist\n\n")
+		t.Logf("Found %d AD application(s) and %d service principal(s) for prefix '%s'",
+			len(identity.Applications), len(identity.ServicePrincipals), prefix)
+	default:
+		PrintToTTY("No %s found for prefix '%s' (set EXPECT_AZURE_IDENTITY=true to require them)\n\n", identity.Missing(), prefix)
+		t.Logf("No %s found for prefix '%s'", identity.Missing(), prefix)
+	}
+}
+
 // TestDeployment_ProviderCredentialsConfigured validates that provider credential secrets
 // are properly configured after applying YAML files.
 // Both ARO and ROSA use namespace-scoped credentials, so no controller restart is needed.
@@ -408,6 +591,7 @@ func TestDeployment_ProviderCredentialsConfigured(t *testing.T) {
 
 // TestDeployment_MonitorCluster tests monitoring the ARO cluster deployment
 func TestDeployment_MonitorCluster(t *testing.T) {
+	TrackPhaseTiming(t)
 
 	PrintToTTY("\n=== Starting Cluster Monitoring Test ===\n")
 
@@ -417,6 +601,7 @@ func TestDeployment_MonitorCluster(t *testing.T) {
 	if config.IsExternalCluster() {
 		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
 	}
+	RequireManagementClusterReachable(t, config)
 
 	PrintToTTY("Checking prerequisites...\n")
 	if !DirExists(config.RepoDir) {
@@ -442,6 +627,8 @@ func TestDeployment_MonitorCluster(t *testing.T) {
 	} else {
 		PrintToTTY("✅ Found clusterctl at: %s\n", clusterctlPath)
 	}
+	VerifyClusterctl(t, config, clusterctlPath)
+	CheckClusterctlVersion(t, config, clusterctlPath)
 
 	context := config.GetKubeContext()
 
@@ -464,6 +651,16 @@ func TestDeployment_MonitorCluster(t *testing.T) {
 	PrintToTTY("✅ Cluster resource exists\n")
 	t.Logf("Cluster resource exists:\n%s", output)
 
+	// Machine-readable mode: stream one JSON object per poll to stdout for external tooling
+	if config.MonitorFormat == "json" {
+		PrintToTTY("\n📊 Streaming cluster status as JSON (MONITOR_FORMAT=json)...\n")
+		if err := MonitorClusterJSON(t, context, config.WorkloadClusterNamespace, provisionedClusterName, os.Stdout); err != nil {
+			t.Errorf("JSON cluster monitoring failed: %v", err)
+		}
+		PrintToTTY("=== Cluster Monitoring Test Complete ===\n\n")
+		return
+	}
+
 	// Use clusterctl to describe the cluster
 	PrintToTTY("\n📊 Fetching cluster status with clusterctl...\n")
 	PrintToTTY("Running: %s describe cluster %s -n %s --show-conditions=all\n", clusterctlPath, provisionedClusterName, config.WorkloadClusterNamespace)
@@ -481,9 +678,114 @@ func TestDeployment_MonitorCluster(t *testing.T) {
 		t.Logf("Cluster status:\n%s", output)
 	}
 
+	// ASO resources carry the Azure-side Ready condition (and Azure error message) that
+	// clusterctl describe does not show
+	asoStatuses, err := GetASOResourceStatus(t, ExecKubeClient{}, context, config.WorkloadClusterNamespace)
+	if err != nil {
+		PrintToTTY("⚠️  Could not get ASO resource status: %v\n\n", err)
+		t.Logf("Could not get ASO resource status: %v", err)
+	} else if len(asoStatuses) > 0 {
+		asoSummary := FormatASOResourceStatus(asoStatuses)
+		PrintToTTY("📊 %s\n", asoSummary)
+		t.Logf("%s", asoSummary)
+	}
+
 	PrintToTTY("=== Cluster Monitoring Test Complete ===\n\n")
 }
 
+// TestDeployment_WaitForInfrastructure waits for the Cluster's InfrastructureReady condition
+// and returns as soon as infrastructure is up, before the control plane wait begins.
+// InfrastructureReady (resource group, networking, identities) typically flips well before
+// ControlPlaneReady, so splitting the two stages lets infra-only failures be diagnosed on
+// their own. TestDeployment_VerifyClusterInfrastructureReady re-checks it later in sequence.
+func TestDeployment_WaitForInfrastructure(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+
+	// Set KUBECONFIG for external cluster mode
+	if config.IsExternalCluster() {
+		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
+	}
+
+	context := config.GetKubeContext()
+	provisionedClusterName := config.GetProvisionedClusterName()
+
+	RequireClusterResource(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
+
+	timeout := config.ClusterDeploymentTimeout
+	pollInterval := 30 * time.Second
+	startTime := time.Now()
+	CollectActivityLogOnFailure(t, config, startTime.Add(-activityLogLookback))
+
+	PrintToTTY("\n=== Waiting for Cluster InfrastructureReady ===\n")
+	PrintToTTY("Cluster: %s | Namespace: %s\n", provisionedClusterName, config.WorkloadClusterNamespace)
+	PrintToTTY("Timeout: %v | Poll interval: %v\n\n", timeout, pollInterval)
+	t.Logf("Waiting for InfrastructureReady (namespace: %s, timeout: %v)...", config.WorkloadClusterNamespace, timeout)
+
+	iteration := 0
+	err := PollUntil(RunContext(), t, timeout, pollInterval, func() (bool, string, error) {
+		iteration++
+		elapsed := time.Since(startTime)
+
+		data, err := MonitorCluster(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
+		if err != nil {
+			return false, fmt.Sprintf("monitor-cluster-json.sh failed: %v", err), nil
+		}
+		if data.Summary.InfrastructureReady {
+			return true, "", nil
+		}
+
+		// Fail-fast: check cluster phase and infrastructure conditions for permanent failures
+		if data.Cluster.Phase == ClusterPhaseFailed {
+			PrintToTTY("\n❌ Cluster phase is Failed — aborting early\n\n")
+			t.Fatalf("Cluster phase is 'Failed' — deployment cannot recover.\n\n"+
+				"Check cluster status:\n"+
+				"  kubectl --context %s -n %s get cluster %s -o yaml",
+				context, config.WorkloadClusterNamespace, provisionedClusterName)
+		}
+		if failErr := CheckK8sConditionsForPermanentFailure(data.Infrastructure.Conditions); failErr != nil {
+			PrintToTTY("\n❌ Permanent failure detected in %s conditions — aborting early\n", data.Infrastructure.Kind)
+			PrintToTTY("   %v\n\n", failErr)
+			t.Fatalf("Permanent failure in %s conditions — deployment cannot recover.\n%v\n\n"+
+				"Check infrastructure status:\n"+
+				"  kubectl --context %s -n %s get %s %s -o yaml",
+				data.Infrastructure.Kind, failErr,
+				context, config.WorkloadClusterNamespace, strings.ToLower(data.Infrastructure.Kind), provisionedClusterName)
+		}
+
+		// Display per-resource infrastructure progress where the provider reports it (ARO)
+		infraStatus := GetInfrastructureResourceStatusFromK8sConditions(data.Infrastructure.Resources, data.Infrastructure.Conditions)
+		if infraStatus.TotalResources > 0 {
+			ReportInfrastructureProgress(t, iteration, elapsed, timeout-elapsed, infraStatus)
+		}
+
+		return false, FormatInfrastructureWaitStatus(data.Cluster.Phase, data.Cluster.Conditions), nil
+	})
+	if err != nil {
+		// Dump diagnostics for not-ready infrastructure resources
+		CollectAndDumpInfraDiagnostics(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
+
+		t.Fatalf("%s waiting for Cluster InfrastructureReady: %v\n\n"+
+			"Infrastructure is provisioned before the control plane, so this points at\n"+
+			"networking, resource group, or identity setup rather than the hosted control plane.\n\n"+
+			"Troubleshooting steps:\n"+
+			"  1. Check cluster conditions: kubectl --context %s -n %s get cluster %s -o yaml\n"+
+			"  2. Check infrastructure resources: kubectl --context %s -n %s get arocluster %s -o yaml\n"+
+			"  3. Check ASO resources: kubectl --context %s -n %s get resourcegroup,virtualnetwork,networksecuritygroup\n"+
+			"  4. Check controller logs: kubectl --context %s -n capz-system logs -l control-plane=controller-manager --tail=100",
+			PollErrorPrefix(err), err,
+			context, config.WorkloadClusterNamespace, provisionedClusterName,
+			context, config.WorkloadClusterNamespace, provisionedClusterName,
+			context, config.WorkloadClusterNamespace,
+			context)
+	}
+
+	elapsed := time.Since(startTime)
+	PrintToTTY("\n✅ Cluster InfrastructureReady is True (took %v)\n\n", elapsed.Round(time.Second))
+	t.Logf("Cluster InfrastructureReady=True (took %v)", elapsed.Round(time.Second))
+}
+
 // TestDeployment_WaitForControlPlane waits for both control plane and machine pool to be ready.
 // These two components deploy in parallel:
 //   - AROControlPlane.Ready: HCP cluster + kubeconfig created
@@ -491,6 +793,7 @@ func TestDeployment_MonitorCluster(t *testing.T) {
 //
 // The test waits for BOTH to be ready before proceeding.
 func TestDeployment_WaitForControlPlane(t *testing.T) {
+	TrackPhaseTiming(t)
 
 	config := NewTestConfig()
 
@@ -499,6 +802,11 @@ func TestDeployment_WaitForControlPlane(t *testing.T) {
 		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
 	}
 
+	ForEachCluster(t, config, waitForControlPlaneForCluster)
+}
+
+// waitForControlPlaneForCluster is TestDeployment_WaitForControlPlane for a single workload cluster.
+func waitForControlPlaneForCluster(t *testing.T, config *TestConfig) {
 	context := config.GetKubeContext()
 
 	// Get the specific resource names for the cluster being deployed
@@ -511,6 +819,7 @@ func TestDeployment_WaitForControlPlane(t *testing.T) {
 	timeout := config.ClusterDeploymentTimeout
 	pollInterval := 30 * time.Second
 	startTime := time.Now()
+	CollectActivityLogOnFailure(t, config, startTime.Add(-activityLogLookback))
 
 	// Get initial status to determine actual control plane kind for display
 	initialData, initErr := MonitorCluster(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
@@ -535,6 +844,11 @@ func TestDeployment_WaitForControlPlane(t *testing.T) {
 
 	controlPlaneReady := false
 	machinePoolReady := false
+	lastFailureReason := ""
+	lastReadyMessage := "" // Last AROControlPlane Ready condition with a message, quoted on timeout
+
+	// Track milestones for best-effort ETA estimates based on the previous run
+	milestones := NewMilestoneTracker(LoadMilestoneDurations())
 
 	stallTimeout := config.DeploymentStallTimeout
 	stallEnabled := stallTimeout > 0
@@ -554,36 +868,11 @@ func TestDeployment_WaitForControlPlane(t *testing.T) {
 	}
 
 	iteration := 0
-	for {
+	err := PollUntil(RunContext(), t, timeout, pollInterval, func() (bool, string, error) {
+		iteration++
 		elapsed := time.Since(startTime)
 		remaining := timeout - elapsed
 
-		if elapsed > timeout {
-			PrintToTTY("\n❌ Timeout reached after %v\n\n", elapsed.Round(time.Second))
-
-			// Dump diagnostics for not-ready infrastructure resources
-			CollectAndDumpInfraDiagnostics(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
-
-			t.Errorf("Timeout waiting for deployment after %v.\n"+
-				"  ControlPlane ready: %v\n"+
-				"  MachinePool ready: %v\n\n"+
-				"Troubleshooting steps:\n"+
-				"  1. Check ControlPlane status: kubectl --context %s -n %s get %s %s -o yaml\n"+
-				"  2. Check MachinePool status: kubectl --context %s -n %s get machinepool %s -o yaml\n"+
-				"  3. Check cluster conditions: kubectl --context %s -n %s get cluster %s -o yaml\n"+
-				"  4. Check controller logs: kubectl --context %s -n capz-system logs -l control-plane=controller-manager --tail=100\n\n"+
-				"To increase timeout: export DEPLOYMENT_TIMEOUT=60m",
-				elapsed.Round(time.Second),
-				controlPlaneReady, machinePoolReady,
-				context, config.WorkloadClusterNamespace, strings.ToLower(controlPlaneKind), controlPlaneName,
-				context, config.WorkloadClusterNamespace, machinePoolName,
-				context, config.WorkloadClusterNamespace, provisionedClusterName,
-				context)
-			return
-		}
-
-		iteration++
-
 		PrintToTTY("[%d] Checking deployment status...\n", iteration)
 
 		// Use MonitorCluster to get status dynamically
@@ -592,8 +881,7 @@ func TestDeployment_WaitForControlPlane(t *testing.T) {
 			PrintToTTY("[%d] ⚠️  monitor-cluster-json.sh failed: %v\n", iteration, err)
 			// lastProgress used as currentProgress: no fresh data, so preserve the phase from the last successful check.
 			checkStallTimeout(t, stallEnabled, stallTimeout, lastProgressTime, lastProgress, lastProgress, context, config.WorkloadClusterNamespace, provisionedClusterName)
-			time.Sleep(pollInterval)
-			continue
+			return false, fmt.Sprintf("monitor-cluster-json.sh failed: %v", err), nil
 		}
 
 		if data.ControlPlane.Kind != "" {
@@ -662,6 +950,27 @@ func TestDeployment_WaitForControlPlane(t *testing.T) {
 			PrintToTTY("[%d] ✅ %s.Ready: true\n", iteration, controlPlaneKind)
 		}
 
+		// Surface why an AROControlPlane is failing (e.g. QuotaExceeded) instead of just waiting
+		if !controlPlaneReady && controlPlaneKind == "AROControlPlane" {
+			// The Ready message carries the Azure error even when no condition looks like a failure
+			cond, condErr := GetAROControlPlaneReady(t, ExecKubeClient{}, context, config.WorkloadClusterNamespace, controlPlaneName)
+			if condErr != nil {
+				t.Logf("Could not read %s Ready condition: %v", controlPlaneKind, condErr)
+			} else if detail := ReportAROReadyCondition(t, iteration, cond); cond != nil && cond.Message != "" {
+				lastReadyMessage = fmt.Sprintf("%s Ready=%s (%s)", controlPlaneKind, cond.Status, detail)
+			}
+
+			reason, reasonErr := GetAROControlPlaneFailureReason(t, context, config.WorkloadClusterNamespace, controlPlaneName)
+			if reasonErr != nil {
+				t.Logf("Could not read %s failure reason: %v", controlPlaneKind, reasonErr)
+			} else {
+				lastFailureReason = reason
+				if reason != "" {
+					PrintToTTY("[%d] ⚠️  %s failure: %s\n", iteration, controlPlaneKind, reason)
+				}
+			}
+		}
+
 		// Check MachinePool status (only for providers that use them, like ARO)
 		if !machinePoolReady {
 			if len(data.MachinePools) == 0 {
@@ -735,6 +1044,14 @@ func TestDeployment_WaitForControlPlane(t *testing.T) {
 			}
 		}
 
+		// Record milestones for ETA estimates
+		if data.Summary.InfrastructureReady && milestones.Observe(MilestoneInfrastructureReady, elapsed) {
+			PrintToTTY("[%d] 🏁 Milestone: %s (after %v)\n", iteration, MilestoneInfrastructureReady, elapsed.Round(time.Second))
+		}
+		if controlPlaneReady && milestones.Observe(MilestoneControlPlaneReady, elapsed) {
+			PrintToTTY("[%d] 🏁 Milestone: %s (after %v)\n", iteration, MilestoneControlPlaneReady, elapsed.Round(time.Second))
+		}
+
 		if stallEnabled {
 			currentCPState := ""
 			if data.ControlPlane.State != nil {
@@ -800,7 +1117,12 @@ func TestDeployment_WaitForControlPlane(t *testing.T) {
 				ReportInfrastructureProgress(t, iteration, elapsed, time.Duration(0), finalInfra)
 			}
 
-			return
+			// Record milestone durations so the next run can estimate remaining time
+			if err := SaveMilestoneDurations(milestones.Durations(elapsed)); err != nil {
+				t.Logf("Warning: failed to save milestone durations: %v", err)
+			}
+
+			return true, "", nil
 		}
 
 		// Display control plane conditions
@@ -825,10 +1147,43 @@ func TestDeployment_WaitForControlPlane(t *testing.T) {
 			ReportInfrastructureProgress(t, iteration, elapsed, remaining, infraStatus)
 		}
 
-		// Report progress using helper function
-		ReportProgress(t, iteration, elapsed, remaining, timeout)
+		// Best-effort ETA based on the most recent milestone
+		if eta := milestones.FormatEstimate(elapsed); eta != "" {
+			PrintToTTY("[%d] %s\n", iteration, eta)
+		}
+
+		// PollUntil reports progress with the status just observed
+		return false, FormatControlPlaneWaitStatus(data.Cluster.Phase, controlPlaneReady, data.ControlPlane.State, machinePoolReady), nil
+	})
+	if err != nil {
+		// Dump diagnostics for not-ready infrastructure resources
+		CollectAndDumpInfraDiagnostics(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
+
+		failureText := ""
+		if lastFailureReason != "" {
+			failureText = fmt.Sprintf("  Control plane failed: %s\n", lastFailureReason)
+		} else if !controlPlaneReady && lastReadyMessage != "" {
+			// No error-looking condition, but the Ready message usually still carries the Azure cause
+			failureText = fmt.Sprintf("  %s\n", lastReadyMessage)
+		}
 
-		time.Sleep(pollInterval)
+		t.Errorf("%s waiting for deployment: %v\n"+
+			"%s"+
+			"  ControlPlane ready: %v\n"+
+			"  MachinePool ready: %v\n\n"+
+			"Troubleshooting steps:\n"+
+			"  1. Check ControlPlane status: kubectl --context %s -n %s get %s %s -o yaml\n"+
+			"  2. Check MachinePool status: kubectl --context %s -n %s get machinepool %s -o yaml\n"+
+			"  3. Check cluster conditions: kubectl --context %s -n %s get cluster %s -o yaml\n"+
+			"  4. Check controller logs: kubectl --context %s -n capz-system logs -l control-plane=controller-manager --tail=100\n\n"+
+			"To increase timeout: export DEPLOYMENT_TIMEOUT=60m",
+			PollErrorPrefix(err), err,
+			failureText,
+			controlPlaneReady, machinePoolReady,
+			context, config.WorkloadClusterNamespace, strings.ToLower(controlPlaneKind), controlPlaneName,
+			context, config.WorkloadClusterNamespace, machinePoolName,
+			context, config.WorkloadClusterNamespace, provisionedClusterName,
+			context)
 	}
 }
 
@@ -844,6 +1199,8 @@ func TestDeployment_WaitForControlPlane(t *testing.T) {
 // "requires at least one ready machine pool" while machine pools are still provisioning.
 // Once machine pools are ready, the controller reconciles ExternalAuth and the condition becomes True.
 func TestDeployment_WaitForExternalAuthReady(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	// ExternalAuthReady is ARO-specific
@@ -869,21 +1226,10 @@ func TestDeployment_WaitForExternalAuthReady(t *testing.T) {
 	PrintToTTY("Timeout: %v | Poll interval: %v\n\n", timeout, pollInterval)
 	t.Logf("Waiting for ExternalAuthReady (namespace: %s, timeout: %v)...", config.WorkloadClusterNamespace, timeout)
 
-	for {
-		elapsed := time.Since(startTime)
-		if elapsed > timeout {
-			PrintToTTY("\n❌ Timeout reached after %v waiting for ExternalAuthReady\n\n", elapsed.Round(time.Second))
-			t.Fatalf("Timeout waiting for ExternalAuthReady after %v.\n\n"+
-				"Check control plane conditions:\n"+
-				"  kubectl --context %s -n %s get arocontrolplane -o yaml",
-				elapsed.Round(time.Second), context, config.WorkloadClusterNamespace)
-		}
-
+	err := PollUntil(RunContext(), t, timeout, pollInterval, func() (bool, string, error) {
 		data, err := MonitorCluster(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
 		if err != nil {
-			PrintToTTY("⏳ Waiting for cluster data... (%v)\n", elapsed.Round(time.Second))
-			time.Sleep(pollInterval)
-			continue
+			return false, "waiting for cluster data", nil
 		}
 
 		// Fail-fast: check all control plane conditions for permanent failures
@@ -898,33 +1244,36 @@ func TestDeployment_WaitForExternalAuthReady(t *testing.T) {
 		}
 
 		// Search for ExternalAuthReady in control plane conditions
-		found := false
 		for _, cond := range data.ControlPlane.Conditions {
-			if cond.Type == "ExternalAuthReady" {
-				found = true
-				if cond.Status == "True" {
-					PrintToTTY("✅ ExternalAuthReady is True (took %v)\n\n", elapsed.Round(time.Second))
-					t.Logf("ExternalAuthReady=True (took %v)", elapsed.Round(time.Second))
-					return
-				}
-				// Show status with reason/message for visibility
-				detail := cond.Status
-				if cond.Reason != "" {
-					detail = fmt.Sprintf("%s (%s)", cond.Status, cond.Reason)
-				}
-				if cond.Message != "" {
-					detail = fmt.Sprintf("%s - %s", detail, cond.Message)
-				}
-				PrintToTTY("⏳ ExternalAuthReady: %s (elapsed %v)\n", detail, elapsed.Round(time.Second))
+			if cond.Type != "ExternalAuthReady" {
+				continue
 			}
+			if cond.Status == "True" {
+				return true, "", nil
+			}
+			// Show status with reason/message for visibility
+			detail := cond.Status
+			if cond.Reason != "" {
+				detail = fmt.Sprintf("%s (%s)", cond.Status, cond.Reason)
+			}
+			if cond.Message != "" {
+				detail = fmt.Sprintf("%s - %s", detail, cond.Message)
+			}
+			return false, "ExternalAuthReady: " + detail, nil
 		}
 
-		if !found {
-			PrintToTTY("⏳ ExternalAuthReady condition not found yet (elapsed %v)\n", elapsed.Round(time.Second))
-		}
-
-		time.Sleep(pollInterval)
+		return false, "ExternalAuthReady condition not found yet", nil
+	})
+	if err != nil {
+		t.Fatalf("%s waiting for ExternalAuthReady: %v\n\n"+
+			"Check control plane conditions:\n"+
+			"  kubectl --context %s -n %s get arocontrolplane -o yaml",
+			PollErrorPrefix(err), err, context, config.WorkloadClusterNamespace)
 	}
+
+	elapsed := time.Since(startTime)
+	PrintToTTY("✅ ExternalAuthReady is True (took %v)\n\n", elapsed.Round(time.Second))
+	t.Logf("ExternalAuthReady=True (took %v)", elapsed.Round(time.Second))
 }
 
 // TestDeployment_VerifyInfrastructureResources waits for AROCluster infrastructure to be fully ready.
@@ -938,6 +1287,8 @@ func TestDeployment_WaitForExternalAuthReady(t *testing.T) {
 // NOTE: This is ARO-specific. ROSA uses a managed service model where infrastructure
 // is handled automatically - once ROSAControlPlane is ready, deployment can proceed.
 func TestDeployment_VerifyInfrastructureResources(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	// Skip for non-ARO providers (NetworkInfrastructureReady and .status.resources[] are ARO-specific)
@@ -965,30 +1316,16 @@ func TestDeployment_VerifyInfrastructureResources(t *testing.T) {
 	t.Logf("Waiting for NetworkInfrastructureReady (namespace: %s, timeout: %v)...", config.WorkloadClusterNamespace, timeout)
 
 	iteration := 0
-	for {
+	var infraStatus InfrastructureResourceStatus
+	err := PollUntil(RunContext(), t, timeout, pollInterval, func() (bool, string, error) {
+		iteration++
 		elapsed := time.Since(startTime)
 		remaining := timeout - elapsed
 
-		if elapsed > timeout {
-			PrintToTTY("\n❌ Timeout reached after %v waiting for NetworkInfrastructureReady\n\n", elapsed.Round(time.Second))
-
-			// Dump diagnostics for not-ready infrastructure resources
-			CollectAndDumpInfraDiagnostics(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
-
-			t.Fatalf("Timeout waiting for NetworkInfrastructureReady after %v.\n\n"+
-				"Check AROCluster status:\n"+
-				"  kubectl --context %s -n %s get arocluster %s -o yaml",
-				elapsed.Round(time.Second), context, config.WorkloadClusterNamespace, provisionedClusterName)
-		}
-
-		iteration++
-
 		// Use MonitorCluster to get status
 		data, err := MonitorCluster(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
 		if err != nil {
-			PrintToTTY("[%d] ⚠️  monitor-cluster-json.sh failed: %v\n", iteration, err)
-			time.Sleep(pollInterval)
-			continue
+			return false, fmt.Sprintf("monitor-cluster-json.sh failed: %v", err), nil
 		}
 
 		// Fail-fast: check infrastructure conditions for permanent failures
@@ -1007,45 +1344,53 @@ func TestDeployment_VerifyInfrastructureResources(t *testing.T) {
 		}
 
 		// Get infrastructure status from already-parsed data
-		infraStatus := GetInfrastructureResourceStatusFromK8sConditions(data.Infrastructure.Resources, data.Infrastructure.Conditions)
+		infraStatus = GetInfrastructureResourceStatusFromK8sConditions(data.Infrastructure.Resources, data.Infrastructure.Conditions)
 
 		if infraStatus.TotalResources == 0 {
-			PrintToTTY("[%d] ⚠️  No infrastructure resources found yet\n", iteration)
-			ReportProgress(t, iteration, elapsed, remaining, timeout)
-			time.Sleep(pollInterval)
-			continue
+			return false, "no infrastructure resources found yet", nil
 		}
 
 		// Display infrastructure progress
 		ReportInfrastructureProgress(t, iteration, elapsed, remaining, infraStatus)
 
 		// Check NetworkInfrastructureReady condition
+		status := "NetworkInfrastructureReady condition not reported yet"
 		for _, cond := range infraStatus.Conditions {
 			if cond.Type == "NetworkInfrastructureReady" {
 				if cond.Status == "True" {
-					PrintToTTY("\n✅ NetworkInfrastructureReady is True (took %v)\n", elapsed.Round(time.Second))
-					PrintToTTY("✅ %d/%d infrastructure resources reconciled\n\n",
-						infraStatus.ReadyResources, infraStatus.TotalResources)
-					t.Logf("NetworkInfrastructureReady=True, %d resources reconciled (took %v)",
-						infraStatus.TotalResources, elapsed.Round(time.Second))
-					return
+					return true, "", nil
 				}
-				detail := cond.Status
+				status = "NetworkInfrastructureReady: " + cond.Status
 				if cond.Reason != "" {
-					detail = fmt.Sprintf("%s (%s)", cond.Status, cond.Reason)
+					status += fmt.Sprintf(" (%s)", cond.Reason)
 				}
-				PrintToTTY("[%d] ⏳ NetworkInfrastructureReady: %s\n", iteration, detail)
 			}
 		}
+		return false, status, nil
+	})
+	if err != nil {
+		// Dump diagnostics for not-ready infrastructure resources
+		CollectAndDumpInfraDiagnostics(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
 
-		ReportProgress(t, iteration, elapsed, remaining, timeout)
-		time.Sleep(pollInterval)
+		t.Fatalf("%s waiting for NetworkInfrastructureReady: %v\n\n"+
+			"Check AROCluster status:\n"+
+			"  kubectl --context %s -n %s get arocluster %s -o yaml",
+			PollErrorPrefix(err), err, context, config.WorkloadClusterNamespace, provisionedClusterName)
 	}
+
+	elapsed := time.Since(startTime)
+	PrintToTTY("\n✅ NetworkInfrastructureReady is True (took %v)\n", elapsed.Round(time.Second))
+	PrintToTTY("✅ %d/%d infrastructure resources reconciled\n\n",
+		infraStatus.ReadyResources, infraStatus.TotalResources)
+	t.Logf("NetworkInfrastructureReady=True, %d resources reconciled (took %v)",
+		infraStatus.TotalResources, elapsed.Round(time.Second))
 }
 
 // TestDeployment_VerifyAROClusterReady verifies AROCluster.status.ready becomes True.
 // This follows AROControlPlane.Ready (step 8) in the deployment sequence.
 func TestDeployment_VerifyAROClusterReady(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	// Skip for non-ARO providers (AROCluster.Ready is ARO-specific)
@@ -1079,61 +1424,51 @@ func TestDeployment_VerifyAROClusterReady(t *testing.T) {
 	PrintToTTY("Command: kubectl --context %s -n %s get %s %s -o jsonpath={.status.ready}\n\n",
 		context, config.WorkloadClusterNamespace, infraResourceType, provisionedClusterName)
 
-	for {
-		elapsed := time.Since(startTime)
-		if elapsed > timeout {
-			// Dump diagnostics for not-ready infrastructure resources
-			CollectAndDumpInfraDiagnostics(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
-
-			t.Fatalf("Timeout after %v waiting for %s.Ready=true.\n"+
-				"  kubectl --context %s -n %s get %s %s -o yaml",
-				elapsed.Round(time.Second), infraKind, context, config.WorkloadClusterNamespace, infraResourceType, provisionedClusterName)
-		}
-
+	err = PollUntil(RunContext(), t, timeout, pollInterval, func() (bool, string, error) {
 		// Use monitoring script to get infrastructure status
 		data, err := MonitorCluster(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
-		var ready bool
-		var status string
-		if err == nil && data.Infrastructure.Ready {
-			ready = true
-			status = "true"
-		} else if err == nil {
-			status = "false"
-		} else {
-			status = "<not set yet>"
+		if err != nil {
+			return false, infraKind + ".Ready: <not set yet>", nil
 		}
-
-		if ready {
-			PrintToTTY("✅ %s.Ready is True (took %v)\n\n", data.Infrastructure.Kind, elapsed.Round(time.Second))
-			t.Logf("%s.Ready=true (took %v)", data.Infrastructure.Kind, elapsed.Round(time.Second))
-			return
+		if data.Infrastructure.Kind != "" {
+			infraKind = data.Infrastructure.Kind
+		}
+		if data.Infrastructure.Ready {
+			return true, "", nil
 		}
 
 		// Fail-fast: check infrastructure conditions for permanent failures
-		if err == nil {
-			if failErr := CheckK8sConditionsForPermanentFailure(data.Infrastructure.Conditions); failErr != nil {
-				PrintToTTY("\n❌ Permanent failure detected in %s conditions — aborting early\n", data.Infrastructure.Kind)
-				PrintToTTY("   %v\n\n", failErr)
-				t.Fatalf("Permanent failure in %s conditions — deployment cannot recover.\n%v\n\n"+
-					"Check infrastructure status:\n"+
-					"  kubectl --context %s -n %s get %s %s -o yaml",
-					data.Infrastructure.Kind, failErr,
-					context, config.WorkloadClusterNamespace, infraResourceType, provisionedClusterName)
-			}
+		if failErr := CheckK8sConditionsForPermanentFailure(data.Infrastructure.Conditions); failErr != nil {
+			PrintToTTY("\n❌ Permanent failure detected in %s conditions — aborting early\n", data.Infrastructure.Kind)
+			PrintToTTY("   %v\n\n", failErr)
+			t.Fatalf("Permanent failure in %s conditions — deployment cannot recover.\n%v\n\n"+
+				"Check infrastructure status:\n"+
+				"  kubectl --context %s -n %s get %s %s -o yaml",
+				data.Infrastructure.Kind, failErr,
+				context, config.WorkloadClusterNamespace, infraResourceType, provisionedClusterName)
 		}
 
-		displayKind := infraKind
-		if data != nil && data.Infrastructure.Kind != "" {
-			displayKind = data.Infrastructure.Kind
-		}
-		PrintToTTY("⏳ %s.Ready: %s (elapsed %v)\n", displayKind, status, elapsed.Round(time.Second))
-		time.Sleep(pollInterval)
+		return false, infraKind + ".Ready: false", nil
+	})
+	if err != nil {
+		// Dump diagnostics for not-ready infrastructure resources
+		CollectAndDumpInfraDiagnostics(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
+
+		t.Fatalf("%s waiting for %s.Ready=true: %v\n"+
+			"  kubectl --context %s -n %s get %s %s -o yaml",
+			PollErrorPrefix(err), infraKind, err, context, config.WorkloadClusterNamespace, infraResourceType, provisionedClusterName)
 	}
+
+	elapsed := time.Since(startTime)
+	PrintToTTY("✅ %s.Ready is True (took %v)\n\n", infraKind, elapsed.Round(time.Second))
+	t.Logf("%s.Ready=true (took %v)", infraKind, elapsed.Round(time.Second))
 }
 
 // TestDeployment_VerifyClusterProvisioned verifies cluster.status.initialization.infrastructureProvisioned becomes True.
 // This follows AROCluster.Ready (step 9) in the deployment sequence.
 func TestDeployment_VerifyClusterProvisioned(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	if config.IsExternalCluster() {
@@ -1154,63 +1489,54 @@ func TestDeployment_VerifyClusterProvisioned(t *testing.T) {
 	PrintToTTY("Command: kubectl --context %s -n %s get cluster %s -o jsonpath={.status.initialization.infrastructureProvisioned}\n\n",
 		context, config.WorkloadClusterNamespace, provisionedClusterName)
 
-	for {
-		elapsed := time.Since(startTime)
-		if elapsed > timeout {
-			// Dump diagnostics for not-ready infrastructure resources
-			CollectAndDumpInfraDiagnostics(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
-
-			t.Fatalf("Timeout after %v waiting for cluster.status.initialization.infrastructureProvisioned=true.\n"+
-				"  kubectl --context %s -n %s get cluster %s -o yaml",
-				elapsed.Round(time.Second), context, config.WorkloadClusterNamespace, provisionedClusterName)
-		}
-
+	err := PollUntil(RunContext(), t, timeout, pollInterval, func() (bool, string, error) {
 		// Use monitoring script to get cluster infrastructure status
 		data, err := MonitorCluster(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
-		var provisioned bool
-		var status string
-		if err == nil && data.Cluster.InfrastructureProvisioned {
-			provisioned = true
-			status = "true"
-		} else if err == nil {
-			status = "false"
-		} else {
-			status = "<not set yet>"
+		if err != nil {
+			return false, "Cluster.Initialization.InfrastructureProvisioned: <not set yet>", nil
 		}
-
-		if provisioned {
-			PrintToTTY("✅ Cluster.Initialization.InfrastructureProvisioned is True (took %v)\n\n", elapsed.Round(time.Second))
-			t.Logf("cluster.status.initialization.infrastructureProvisioned=true (took %v)", elapsed.Round(time.Second))
-			return
+		if data.Cluster.InfrastructureProvisioned {
+			return true, "", nil
 		}
 
 		// Fail-fast: check cluster phase and conditions for permanent failures
-		if err == nil {
-			if data.Cluster.Phase == ClusterPhaseFailed {
-				PrintToTTY("\n❌ Cluster phase is Failed — aborting early\n\n")
-				t.Fatalf("Cluster phase is 'Failed' — deployment cannot recover.\n\n"+
-					"Check cluster status:\n"+
-					"  kubectl --context %s -n %s get cluster %s -o yaml",
-					context, config.WorkloadClusterNamespace, provisionedClusterName)
-			}
-			if failErr := CheckK8sConditionsForPermanentFailure(data.Cluster.Conditions); failErr != nil {
-				PrintToTTY("\n❌ Permanent failure detected in Cluster conditions — aborting early\n")
-				PrintToTTY("   %v\n\n", failErr)
-				t.Fatalf("Permanent failure in Cluster conditions — deployment cannot recover.\n%v\n\n"+
-					"Check cluster status:\n"+
-					"  kubectl --context %s -n %s get cluster %s -o yaml",
-					failErr, context, config.WorkloadClusterNamespace, provisionedClusterName)
-			}
+		if data.Cluster.Phase == ClusterPhaseFailed {
+			PrintToTTY("\n❌ Cluster phase is Failed — aborting early\n\n")
+			t.Fatalf("Cluster phase is 'Failed' — deployment cannot recover.\n\n"+
+				"Check cluster status:\n"+
+				"  kubectl --context %s -n %s get cluster %s -o yaml",
+				context, config.WorkloadClusterNamespace, provisionedClusterName)
+		}
+		if failErr := CheckK8sConditionsForPermanentFailure(data.Cluster.Conditions); failErr != nil {
+			PrintToTTY("\n❌ Permanent failure detected in Cluster conditions — aborting early\n")
+			PrintToTTY("   %v\n\n", failErr)
+			t.Fatalf("Permanent failure in Cluster conditions — deployment cannot recover.\n%v\n\n"+
+				"Check cluster status:\n"+
+				"  kubectl --context %s -n %s get cluster %s -o yaml",
+				failErr, context, config.WorkloadClusterNamespace, provisionedClusterName)
 		}
 
-		PrintToTTY("⏳ Cluster.Initialization.InfrastructureProvisioned: %s (elapsed %v)\n", status, elapsed.Round(time.Second))
-		time.Sleep(pollInterval)
+		return false, "Cluster.Initialization.InfrastructureProvisioned: false", nil
+	})
+	if err != nil {
+		// Dump diagnostics for not-ready infrastructure resources
+		CollectAndDumpInfraDiagnostics(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
+
+		t.Fatalf("%s waiting for cluster.status.initialization.infrastructureProvisioned=true: %v\n"+
+			"  kubectl --context %s -n %s get cluster %s -o yaml",
+			PollErrorPrefix(err), err, context, config.WorkloadClusterNamespace, provisionedClusterName)
 	}
+
+	elapsed := time.Since(startTime)
+	PrintToTTY("✅ Cluster.Initialization.InfrastructureProvisioned is True (took %v)\n\n", elapsed.Round(time.Second))
+	t.Logf("cluster.status.initialization.infrastructureProvisioned=true (took %v)", elapsed.Round(time.Second))
 }
 
 // TestDeployment_VerifyClusterInfrastructureReady verifies CAPI Cluster InfrastructureReady condition becomes True.
 // This follows Cluster.Initialization.InfrastructureProvisioned (step 10) in the deployment sequence.
 func TestDeployment_VerifyClusterInfrastructureReady(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	if config.IsExternalCluster() {
@@ -1231,64 +1557,55 @@ func TestDeployment_VerifyClusterInfrastructureReady(t *testing.T) {
 	PrintToTTY("Command: kubectl --context %s -n %s get cluster %s -o jsonpath={.status.conditions[?(@.type=='InfrastructureReady')].status}\n\n",
 		context, config.WorkloadClusterNamespace, provisionedClusterName)
 
-	for {
-		elapsed := time.Since(startTime)
-		if elapsed > timeout {
-			// Dump diagnostics for not-ready infrastructure resources
-			CollectAndDumpInfraDiagnostics(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
-
-			t.Fatalf("Timeout after %v waiting for Cluster InfrastructureReady=True.\n"+
-				"  kubectl --context %s -n %s get cluster %s -o yaml",
-				elapsed.Round(time.Second), context, config.WorkloadClusterNamespace, provisionedClusterName)
-		}
-
-		// Use monitoring script to get cluster infrastructure ready condition
+	err := PollUntil(RunContext(), t, timeout, pollInterval, func() (bool, string, error) {
+		// Use monitoring script to get cluster infrastructure status
 		data, err := MonitorCluster(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
-		var ready bool
-		var status string
-		if err == nil && data.Summary.InfrastructureReady {
-			ready = true
-			status = "True"
-		} else if err == nil {
-			status = "False"
-		} else {
-			status = "<not set yet>"
+		if err != nil {
+			return false, "Cluster.InfrastructureReady: <not set yet>", nil
 		}
-
-		if ready {
-			PrintToTTY("✅ Cluster.InfrastructureReady is True (took %v)\n\n", elapsed.Round(time.Second))
-			t.Logf("Cluster InfrastructureReady=True (took %v)", elapsed.Round(time.Second))
-			return
+		if data.Summary.InfrastructureReady {
+			return true, "", nil
 		}
 
 		// Fail-fast: check cluster phase and conditions for permanent failures
-		if err == nil {
-			if data.Cluster.Phase == ClusterPhaseFailed {
-				PrintToTTY("\n❌ Cluster phase is Failed — aborting early\n\n")
-				t.Fatalf("Cluster phase is 'Failed' — deployment cannot recover.\n\n"+
-					"Check cluster status:\n"+
-					"  kubectl --context %s -n %s get cluster %s -o yaml",
-					context, config.WorkloadClusterNamespace, provisionedClusterName)
-			}
-			if failErr := CheckK8sConditionsForPermanentFailure(data.Cluster.Conditions); failErr != nil {
-				PrintToTTY("\n❌ Permanent failure detected in Cluster conditions — aborting early\n")
-				PrintToTTY("   %v\n\n", failErr)
-				t.Fatalf("Permanent failure in Cluster conditions — deployment cannot recover.\n%v\n\n"+
-					"Check cluster status:\n"+
-					"  kubectl --context %s -n %s get cluster %s -o yaml",
-					failErr, context, config.WorkloadClusterNamespace, provisionedClusterName)
-			}
+		if data.Cluster.Phase == ClusterPhaseFailed {
+			PrintToTTY("\n❌ Cluster phase is Failed — aborting early\n\n")
+			t.Fatalf("Cluster phase is 'Failed' — deployment cannot recover.\n\n"+
+				"Check cluster status:\n"+
+				"  kubectl --context %s -n %s get cluster %s -o yaml",
+				context, config.WorkloadClusterNamespace, provisionedClusterName)
 		}
+		if failErr := CheckK8sConditionsForPermanentFailure(data.Cluster.Conditions); failErr != nil {
+			PrintToTTY("\n❌ Permanent failure detected in Cluster conditions — aborting early\n")
+			PrintToTTY("   %v\n\n", failErr)
+			t.Fatalf("Permanent failure in Cluster conditions — deployment cannot recover.\n%v\n\n"+
+				"Check cluster status:\n"+
+				"  kubectl --context %s -n %s get cluster %s -o yaml",
+				failErr, context, config.WorkloadClusterNamespace, provisionedClusterName)
+		}
+
+		return false, "Cluster.InfrastructureReady: False", nil
+	})
+	if err != nil {
+		// Dump diagnostics for not-ready infrastructure resources
+		CollectAndDumpInfraDiagnostics(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
 
-		PrintToTTY("⏳ Cluster.InfrastructureReady: %s (elapsed %v)\n", status, elapsed.Round(time.Second))
-		time.Sleep(pollInterval)
+		t.Fatalf("%s waiting for Cluster InfrastructureReady=True: %v\n"+
+			"  kubectl --context %s -n %s get cluster %s -o yaml",
+			PollErrorPrefix(err), err, context, config.WorkloadClusterNamespace, provisionedClusterName)
 	}
+
+	elapsed := time.Since(startTime)
+	PrintToTTY("✅ Cluster.InfrastructureReady is True (took %v)\n\n", elapsed.Round(time.Second))
+	t.Logf("Cluster InfrastructureReady=True (took %v)", elapsed.Round(time.Second))
 }
 
 // TestDeployment_TagAWSResources tags AWS resources (CloudFormation stacks and VPCs) created
 // by the CAPA controller with ownership metadata for stale resource detection and cleanup.
 // Non-fatal: failures are logged as warnings since tagging is for cleanup convenience only.
 func TestDeployment_TagAWSResources(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	config := NewTestConfig()
 
 	if len(config.ResourceTags) == 0 {
@@ -1519,3 +1836,30 @@ func checkStallTimeout(t *testing.T, stallEnabled bool, stallTimeout time.Durati
 		lastProgress.cpReady, lastProgress.cpState,
 		lastProgress.mpReadyReplicas, lastProgress.mpProvisioningState)
 }
+
+// deployPhaseSteps returns this file's tests in the order they must run.
+func deployPhaseSteps() []e2eStep {
+	return []e2eStep{
+		{name: "00_CreateNamespace", run: TestDeployment_00_CreateNamespace},
+		{name: "01_CheckExistingClusters", run: TestDeployment_01_CheckExistingClusters},
+		{name: "ApplyResources", run: TestDeployment_ApplyResources},
+		{name: "ApplyClusterYAMLs", run: TestDeployment_ApplyClusterYAMLs},
+		{name: "TagAzureResources", run: TestDeployment_TagAzureResources},
+		{name: "VerifyIdentityCreated", run: TestDeployment_VerifyIdentityCreated},
+		{name: "ProviderCredentialsConfigured", run: TestDeployment_ProviderCredentialsConfigured},
+		{name: "MonitorCluster", run: TestDeployment_MonitorCluster},
+		{name: "WaitForInfrastructure", run: TestDeployment_WaitForInfrastructure},
+		{name: "WaitForControlPlane", run: TestDeployment_WaitForControlPlane},
+		{name: "WaitForExternalAuthReady", run: TestDeployment_WaitForExternalAuthReady},
+		{name: "VerifyInfrastructureResources", run: TestDeployment_VerifyInfrastructureResources},
+		{name: "VerifyAROClusterReady", run: TestDeployment_VerifyAROClusterReady},
+		{name: "VerifyClusterProvisioned", run: TestDeployment_VerifyClusterProvisioned},
+		{name: "VerifyClusterInfrastructureReady", run: TestDeployment_VerifyClusterInfrastructureReady},
+		{name: "TagAWSResources", run: TestDeployment_TagAWSResources},
+	}
+}
+
+// TestDeployment_ZZZ_RunPhase runs deployPhaseSteps in order via runOrderedPhase.
+func TestDeployment_ZZZ_RunPhase(t *testing.T) {
+	runOrderedPhase(t, deployPhaseSteps())
+}
@@ -1,11 +1,12 @@
 package test
 
 import (
-	"encoding/base64"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -14,19 +15,52 @@ import (
 // This is calculated deterministically from the config, allowing tests to find the
 // kubeconfig without relying on environment variables that may be cleaned up.
 func getKubeconfigPath(config *TestConfig) string {
-	provisionedClusterName := config.GetProvisionedClusterName()
-	return filepath.Join(config.SharedTempDir(), fmt.Sprintf("%s-kubeconfig.yaml", provisionedClusterName))
+	return WorkloadKubeconfigPath(config.GetProvisionedClusterName())
+}
+
+// workloadClusterArgs prefixes args with --kubeconfig/--context for the workload cluster,
+// so kubectl/oc target it without overriding KUBECONFIG for the whole test process.
+// MergeKubeconfigContext names the workload context after the provisioned cluster.
+func workloadClusterArgs(config *TestConfig, args ...string) []string {
+	return append([]string{
+		"--kubeconfig", getKubeconfigPath(config),
+		"--context", config.GetProvisionedClusterName(),
+	}, args...)
+}
+
+// requireWorkloadKubeconfig is the shared prologue of the checks run against a workload
+// cluster: it skips when the kubeconfig has not been retrieved, fails when it points at the
+// management cluster, and registers a must-gather for when the check fails.
+func requireWorkloadKubeconfig(t *testing.T, config *TestConfig) string {
+	t.Helper()
+
+	kubeconfigPath := getKubeconfigPath(config)
+	if !FileExists(kubeconfigPath) {
+		t.Skipf("Kubeconfig not available at %s, run TestVerification_RetrieveKubeconfig first", kubeconfigPath)
+	}
+
+	AssertContextIsWorkload(t, kubeconfigPath, config.GetProvisionedClusterName())
+
+	CollectMustGatherOnFailure(t, config, workloadClusterArgs(config))
+	return kubeconfigPath
 }
 
 // TestVerification_RetrieveKubeconfig tests retrieving the cluster kubeconfig
 func TestVerification_RetrieveKubeconfig(t *testing.T) {
+	TrackPhaseTiming(t)
+
 	// Check if config initialization failed
 	if configError != nil {
 		t.Fatalf("Configuration initialization failed: %s", *configError)
 	}
 
-	config := NewTestConfig()
+	CheckToolsForPhase(t, "verify")
 
+	ForEachCluster(t, NewTestConfig(), retrieveKubeconfigForCluster)
+}
+
+// retrieveKubeconfigForCluster is TestVerification_RetrieveKubeconfig for a single workload cluster.
+func retrieveKubeconfigForCluster(t *testing.T, config *TestConfig) {
 	// Set KUBECONFIG for external cluster mode
 	if config.IsExternalCluster() {
 		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
@@ -39,15 +73,22 @@ func TestVerification_RetrieveKubeconfig(t *testing.T) {
 
 	// Check cluster phase before attempting kubeconfig retrieval (fixes #275)
 	// When a cluster is still provisioning, ASO creates the kubeconfig secret with an empty
-	// value, which causes confusing "Secret value is empty" errors.
-	clusterPhase, err := GetClusterPhase(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
+	// value. Instead of skipping, wait for the secret to be populated.
+	clusterPhase, err := GetClusterPhase(t, ExecKubeClient{}, context, config.WorkloadClusterNamespace, provisionedClusterName)
 	if err != nil {
 		t.Skipf("Cannot determine cluster phase: %v (cluster resource may not exist yet)", err)
 	}
 
+	if clusterPhase == ClusterPhaseFailed {
+		t.Skipf("Cluster phase is %s, skipping kubeconfig retrieval", clusterPhase)
+	}
+
+	secretTimeout := DefaultKubeconfigSecretTimeout
 	if clusterPhase != ClusterPhaseProvisioned {
-		t.Skipf("Cluster is not ready (current phase: %s), skipping kubeconfig retrieval. "+
-			"Wait for cluster provisioning to complete or run TestDeployment_WaitForControlPlane first.", clusterPhase)
+		// The secret is populated once the control plane is up, which can take as long as the deployment
+		secretTimeout = config.ClusterDeploymentTimeout
+		t.Logf("Cluster is not ready yet (current phase: %s), waiting up to %v for the kubeconfig secret to be populated",
+			clusterPhase, secretTimeout)
 	}
 
 	// Kubeconfig output path - use helper for consistency
@@ -58,35 +99,13 @@ func TestVerification_RetrieveKubeconfig(t *testing.T) {
 	// Method 1: Using kubectl to get secret
 	secretName := fmt.Sprintf("%s-kubeconfig", provisionedClusterName)
 
-	// Wait for kubeconfig secret to exist (with retry)
+	// Wait for kubeconfig secret to exist and be populated
 	// There can be a brief delay between cluster reaching "Provisioned" phase and secret creation,
 	// especially for ROSA clusters
-	maxRetries := 12 // 12 retries * 5 seconds = 1 minute max wait
-	retryDelay := 5 * time.Second
-	var output string
-	var secretErr error
-
-	t.Logf("Waiting for kubeconfig secret '%s' to be created...", secretName)
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		t.Logf("Attempt %d/%d: kubectl --context %s -n %s get secret %s -o jsonpath={.data.value}",
-			attempt, maxRetries, context, config.WorkloadClusterNamespace, secretName)
-
-		output, secretErr = RunCommandQuiet(t, "kubectl", "--context", context, "-n", config.WorkloadClusterNamespace, "get", "secret",
-			secretName, "-o", "jsonpath={.data.value}")
-
-		if secretErr == nil && strings.TrimSpace(output) != "" {
-			t.Logf("Kubeconfig secret found on attempt %d", attempt)
-			break
-		}
-
-		if attempt < maxRetries {
-			t.Logf("Secret not ready yet, waiting %v before retry %d/%d...", retryDelay, attempt+1, maxRetries)
-			time.Sleep(retryDelay)
-		}
-	}
+	decoded, secretErr := WaitForKubeconfigSecret(t, context, config.WorkloadClusterNamespace, secretName, secretTimeout)
 
 	if secretErr != nil {
-		t.Logf("Method 1 (kubectl get secret) failed after %d retries: %v", maxRetries, secretErr)
+		t.Logf("Method 1 (kubectl get secret) failed: %v", secretErr)
 
 		// Method 2: Try using clusterctl
 		clusterctlPath := filepath.Join(config.RepoDir, config.ClusterctlBinPath)
@@ -95,16 +114,27 @@ func TestVerification_RetrieveKubeconfig(t *testing.T) {
 		}
 
 		if FileExists(clusterctlPath) || CommandExists("clusterctl") {
+			VerifyClusterctl(t, config, clusterctlPath)
 			t.Logf("Attempting Method 2: %s get kubeconfig %s -n %s", clusterctlPath, provisionedClusterName, config.WorkloadClusterNamespace)
 
-			output, err = RunCommandQuiet(t, clusterctlPath, "get", "kubeconfig", provisionedClusterName, "-n", config.WorkloadClusterNamespace)
+			kubeconfig, err := GetKubeconfigWithClusterctl(t, clusterctlPath, provisionedClusterName, config.WorkloadClusterNamespace, 0)
 			if err != nil {
-				t.Errorf("Both kubeconfig retrieval methods failed: %v", err)
+				hint := ""
+				if errors.Is(secretErr, ErrKubeconfigSecretEmpty) && errors.Is(err, ErrKubeconfigSecretEmpty) {
+					hint = "\n\nThe kubeconfig secret exists but was still empty on every read; the control plane may not be fully up yet."
+				}
+				t.Errorf("Both kubeconfig retrieval methods failed:\n  kubectl: %v\n  clusterctl: %v%s", secretErr, err, hint)
+				return
+			}
+			info, err := ValidateKubeconfig(kubeconfig)
+			if err != nil {
+				t.Errorf("Kubeconfig retrieved using clusterctl is invalid: %v", err)
 				return
 			}
+			t.Logf("Kubeconfig targets cluster '%s' at %s", info.ClusterName, info.Server)
 
-			// Write kubeconfig to file
-			if err := os.WriteFile(kubeconfigPath, []byte(output), 0600); err != nil {
+			// Write kubeconfig to an isolated file with a predictable context name
+			if _, _, err := MergeKubeconfigContext(t, kubeconfig, provisionedClusterName); err != nil {
 				t.Errorf("Failed to write kubeconfig to file: %v", err)
 				return
 			}
@@ -114,26 +144,14 @@ func TestVerification_RetrieveKubeconfig(t *testing.T) {
 			t.Skipf("No method available to retrieve kubeconfig")
 		}
 	} else {
-		// Validate secret output is not empty
-		if strings.TrimSpace(output) == "" {
-			t.Errorf("Secret value is empty, cannot decode kubeconfig")
-			return
-		}
-
-		// Decode base64 using Go's encoding/base64 package (safe from command injection)
-		decoded, err := base64.StdEncoding.DecodeString(output)
+		info, err := ValidateKubeconfig(decoded)
 		if err != nil {
-			t.Errorf("Failed to decode kubeconfig (invalid base64): %v", err)
+			t.Errorf("Kubeconfig from secret '%s' is invalid: %v", secretName, err)
 			return
 		}
+		t.Logf("Kubeconfig targets cluster '%s' at %s", info.ClusterName, info.Server)
 
-		// Validate decoded content is not empty
-		if len(decoded) == 0 {
-			t.Errorf("Decoded kubeconfig is empty")
-			return
-		}
-
-		if err := os.WriteFile(kubeconfigPath, decoded, 0600); err != nil {
+		if _, _, err := MergeKubeconfigContext(t, decoded, provisionedClusterName); err != nil {
 			t.Errorf("Failed to write kubeconfig to file: %v", err)
 			return
 		}
@@ -143,6 +161,48 @@ func TestVerification_RetrieveKubeconfig(t *testing.T) {
 
 	// Store kubeconfig path for other tests
 	SetEnvVar(t, "ARO_CLUSTER_KUBECONFIG", kubeconfigPath)
+
+	checkServerCertificate(t, config, kubeconfigPath)
+}
+
+// checkServerCertificate verifies the workload API server presents a certificate chaining to
+// the CA in the retrieved kubeconfig and reports its subject, issuer and expiry. An untrusted
+// or expired certificate fails the test; an unreachable server only warns, since the
+// verification tests that follow report connectivity problems in more detail.
+func checkServerCertificate(t *testing.T, config *TestConfig, kubeconfigPath string) {
+	t.Helper()
+
+	data, err := os.ReadFile(kubeconfigPath) // #nosec G304 - path written by this test
+	if err != nil {
+		t.Errorf("Failed to read kubeconfig %s: %v", kubeconfigPath, err)
+		return
+	}
+
+	cert, err := CheckKubeconfigServerCert(RunContext(), data, config.ProxySettings())
+	switch {
+	case err == nil:
+		PrintToTTY("✅ API server certificate verified against kubeconfig CA: %s\n", cert)
+		t.Logf("API server certificate verified against kubeconfig CA: %s", cert)
+	case errors.Is(err, ErrServerCertUntrusted):
+		presented := "no certificate presented"
+		if cert != nil {
+			presented = cert.String()
+		}
+		PrintToTTY("❌ %v\n", err)
+		t.Errorf("%v\n\nPresented certificate: %s\n\n"+
+			"The kubeconfig CA does not trust the certificate the API server presented. This usually means:\n"+
+			"  1. A proxy between this host and the cluster intercepts TLS (check HTTPS_PROXY/COMMAND_HTTPS_PROXY and NO_PROXY)\n"+
+			"  2. The API server serving certificate has expired or was rotated without updating the kubeconfig secret\n\n"+
+			"To fix this:\n"+
+			"  1. Exclude the API server host from TLS interception, e.g. add it to NO_PROXY\n"+
+			"  2. Re-retrieve the kubeconfig: go test -v ./test -run TestVerification_RetrieveKubeconfig",
+			err, presented)
+	case errors.Is(err, ErrKubeconfigNoCA):
+		t.Logf("Skipping API server certificate check: %v", err)
+	default:
+		PrintToTTY("⚠️  Could not check API server certificate: %v\n", err)
+		t.Logf("Warning: could not check API server certificate: %v", err)
+	}
 }
 
 // TestVerification_ClusterNodes verifies cluster nodes are available.
@@ -150,19 +210,23 @@ func TestVerification_RetrieveKubeconfig(t *testing.T) {
 // The AROMachinePool creates nodes after the HcpOpenShiftCluster is up, so this
 // test polls until at least one node appears or the timeout is reached.
 func TestVerification_ClusterNodes(t *testing.T) {
+	TrackPhaseTiming(t)
 
 	config := NewTestConfig()
-	kubeconfigPath := getKubeconfigPath(config)
-
-	if !FileExists(kubeconfigPath) {
-		t.Skipf("Kubeconfig not available at %s, run TestVerification_RetrieveKubeconfig first", kubeconfigPath)
-	}
+	skipIfVerifyParallel(t, config)
 
 	// Set KUBECONFIG for external cluster mode (management cluster)
 	if config.IsExternalCluster() {
 		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
 	}
 
+	ForEachCluster(t, config, clusterNodesForCluster)
+}
+
+// clusterNodesForCluster is TestVerification_ClusterNodes for a single workload cluster.
+func clusterNodesForCluster(t *testing.T, config *TestConfig) {
+	kubeconfigPath := requireWorkloadKubeconfig(t, config)
+
 	context := config.GetKubeContext()
 	provisionedClusterName := config.GetProvisionedClusterName()
 
@@ -175,121 +239,154 @@ func TestVerification_ClusterNodes(t *testing.T) {
 	t.Logf("Waiting for cluster nodes (timeout: %v)...", timeout)
 
 	iteration := 0
-	for {
-		elapsed := time.Since(startTime)
-		remaining := timeout - elapsed
-
-		if elapsed > timeout {
-			PrintToTTY("\n❌ Timeout reached after %v waiting for nodes\n\n", elapsed.Round(time.Second))
-			t.Errorf("Timeout waiting for cluster nodes after %v.\n\n"+
-				"Troubleshooting steps:\n"+
-				"  1. Check MachinePool status: kubectl --context %s -n %s get machinepool\n"+
-				"  2. Check AROMachinePool status: kubectl --context %s -n %s get aromachinepool\n"+
-				"  3. Check nodes: KUBECONFIG=%s kubectl get nodes\n",
-				elapsed.Round(time.Second),
-				config.GetKubeContext(), config.WorkloadClusterNamespace,
-				config.GetKubeContext(), config.WorkloadClusterNamespace,
-				kubeconfigPath)
-			return
-		}
-
+	err := PollUntil(RunContext(), t, timeout, pollInterval, func() (bool, string, error) {
 		iteration++
-		PrintToTTY("[%d] Checking cluster nodes...\n", iteration)
 
 		// Use monitor script to get cluster status (including nodes)
 		data, err := MonitorCluster(t, context, config.WorkloadClusterNamespace, provisionedClusterName)
 		if err != nil {
-			PrintToTTY("[%d] ⚠️  Failed to monitor cluster: %v\n", iteration, err)
 			t.Logf("Failed to monitor cluster (attempt %d): %v", iteration, err)
-			ReportProgress(t, iteration, elapsed, remaining, timeout)
-			time.Sleep(pollInterval)
-			continue
+			return false, fmt.Sprintf("failed to monitor cluster: %v", err), nil
+		}
+
+		if len(data.Nodes) > 0 {
+			t.Logf("Cluster has %d node(s)", len(data.Nodes))
+			return true, "", nil
 		}
 
 		// Check if there's an error connecting to the workload cluster
 		if data.NodesError != nil && *data.NodesError != "" {
-			PrintToTTY("[%d] ⚠️  Unable to connect to cluster: %s\n", iteration, *data.NodesError)
 			t.Logf("Unable to connect to cluster (attempt %d): %s", iteration, *data.NodesError)
+			return false, "unable to connect to cluster: " + *data.NodesError, nil
 		}
+		return false, "no nodes found yet", nil
+	})
+	if err != nil {
+		t.Errorf("%s waiting for cluster nodes: %v\n\n"+
+			"Troubleshooting steps:\n"+
+			"  1. Check MachinePool status: kubectl --context %s -n %s get machinepool\n"+
+			"  2. Check AROMachinePool status: kubectl --context %s -n %s get aromachinepool\n"+
+			"  3. Check nodes: kubectl --kubeconfig %s --context %s get nodes\n",
+			PollErrorPrefix(err), err,
+			config.GetKubeContext(), config.WorkloadClusterNamespace,
+			config.GetKubeContext(), config.WorkloadClusterNamespace,
+			kubeconfigPath, provisionedClusterName)
+		return
+	}
 
-		// Check nodes
-		nodeCount := len(data.Nodes)
-		if nodeCount > 0 {
-			PrintToTTY("\n✅ Cluster nodes available! (took %v)\n", elapsed.Round(time.Second))
-			t.Logf("Cluster has %d node(s)", nodeCount)
-
-			// Print node details using workload cluster kubeconfig
-			PrintToTTY("Running: kubectl get nodes\n\n")
-			// Temporarily set KUBECONFIG to workload cluster for this command
-			oldKubeconfig := os.Getenv("KUBECONFIG")
-			if err := os.Setenv("KUBECONFIG", kubeconfigPath); err != nil {
-				t.Fatalf("Failed to set KUBECONFIG: %v", err)
-			}
-			defer func() {
-				if oldKubeconfig == "" {
-					os.Unsetenv("KUBECONFIG")
-				} else {
-					os.Setenv("KUBECONFIG", oldKubeconfig)
-				}
-			}()
-			output, err := RunCommand(t, "kubectl", "get", "nodes")
+	PrintToTTY("\n✅ Cluster nodes available! (took %v)\n", time.Since(startTime).Round(time.Second))
 
-			if err == nil {
-				PrintToTTY("%s\n\n", output)
-				t.Logf("Cluster nodes:\n%s", output)
-			}
-			return
-		}
-
-		if nodeCount == 0 {
-			if data.NodesError == nil || *data.NodesError == "" {
-				PrintToTTY("[%d] ⏳ No nodes found yet\n", iteration)
-			}
+	// The first node only shows the cluster started scaling; wait for every replica the
+	// MachinePools ask for so a partially scaled cluster is not reported as ready
+	clusterYAMLPath := filepath.Join(config.RepoDir, config.GetOutputDirName(), config.ClusterYAML)
+	if config.KustomizeDir != "" {
+		// The overlay may patch replicas; count what was actually applied
+		clusterYAMLPath = filepath.Join(config.RepoDir, config.GetOutputDirName(), KustomizeBuildFileName)
+	}
+	expected, err := ExpectedNodeCount(clusterYAMLPath)
+	if err != nil {
+		t.Logf("Warning: cannot determine expected node count from %s, not waiting for all nodes: %v", clusterYAMLPath, err)
+	} else {
+		PrintToTTY("\n=== Waiting for %d Ready node(s) across MachinePools ===\n", expected)
+		if err := WaitForExpectedNodes(t, workloadClusterArgs(config), expected, timeout-time.Since(startTime)); err != nil {
+			t.Errorf("Cluster is only partially scaled: %v\n\n"+
+				"Troubleshooting steps:\n"+
+				"  1. Check MachinePool replicas: kubectl --context %s -n %s get machinepool\n"+
+				"  2. Check nodes: kubectl --kubeconfig %s --context %s get nodes\n",
+				err,
+				config.GetKubeContext(), config.WorkloadClusterNamespace,
+				kubeconfigPath, provisionedClusterName)
+		} else {
+			PrintToTTY("✅ All %d expected node(s) are Ready (took %v)\n", expected, time.Since(startTime).Round(time.Second))
 		}
+	}
 
-		ReportProgress(t, iteration, elapsed, remaining, timeout)
-		time.Sleep(pollInterval)
+	// Print node details using workload cluster kubeconfig
+	output, err := RunCommandQuiet(t, "kubectl", workloadClusterArgs(config, "get", "nodes", "-o", "json")...)
+	if err != nil {
+		t.Logf("Warning: failed to list nodes: %v", err)
+		return
+	}
+	nodes, err := ParseNodeList(output)
+	if err != nil {
+		t.Logf("Warning: %v", err)
+		return
+	}
+	table := FormatNodeList(nodes, time.Now())
+	PrintToTTY("\n%s\n", table)
+	t.Logf("Cluster has %d node(s), %d Ready:\n%s", len(nodes), CountReadyNodes(nodes), table)
+
+	// Nodes from different MachinePools should run the same kubelet after install
+	if skew := NodeVersionSkew(nodes); skew != nil {
+		PrintToTTY("⚠️  Nodes run different Kubernetes versions: %s\n\n", strings.Join(skew, ", "))
+		t.Logf("Warning: nodes run different Kubernetes versions: %s", strings.Join(skew, ", "))
 	}
 }
 
 // TestVerification_ClusterVersion verifies the OpenShift cluster version
 func TestVerification_ClusterVersion(t *testing.T) {
+	TrackPhaseTiming(t)
 
 	config := NewTestConfig()
-	kubeconfigPath := getKubeconfigPath(config)
+	skipIfVerifyParallel(t, config)
 
-	if !FileExists(kubeconfigPath) {
-		t.Skipf("Kubeconfig not available at %s, run TestVerification_RetrieveKubeconfig first", kubeconfigPath)
-	}
+	ForEachCluster(t, config, clusterVersionForCluster)
+}
+
+// clusterVersionForCluster is TestVerification_ClusterVersion for a single workload cluster.
+func clusterVersionForCluster(t *testing.T, config *TestConfig) {
+	requireWorkloadKubeconfig(t, config)
 
 	t.Log("Checking OpenShift cluster version...")
 
-	SetEnvVar(t, "KUBECONFIG", kubeconfigPath)
+	output, err := RunCommand(t, "oc", workloadClusterArgs(config, "version")...)
+	serverVersion := ParseOCVersion(output)
+	if serverVersion == "" {
+		// oc prints the client version and exits non-zero while the API server is unreachable
+		t.Skipf("Server version not available (cluster may still be provisioning): %v\nOutput: %s", err, output)
+	}
 
-	output, err := RunCommand(t, "oc", "version")
-	if err != nil {
-		t.Logf("Failed to get cluster version (cluster may still be provisioning): %v\nOutput: %s", err, output)
+	t.Logf("OpenShift version:\n%s", output)
+
+	want := VersionMajorMinor(config.OCPVersion)
+	if want == "" {
+		t.Logf("Warning: OCP_VERSION %q is not a major.minor version; not checking the server version", config.OCPVersion)
+		return
+	}
+	if got := VersionMajorMinor(serverVersion); got != want {
+		controlPlaneResource := "arocontrolplane"
+		if config.HasProvider("rosa") {
+			controlPlaneResource = "rosacontrolplane"
+		}
+		PrintToTTY("❌ OpenShift %s does not match requested OCP_VERSION %s\n\n", serverVersion, config.OCPVersion)
+		t.Errorf("Cluster runs OpenShift %s, but OCP_VERSION requested %s\n\n"+
+			"The installer may have ignored the requested version or picked a different channel.\n"+
+			"Check the version in the generated control plane: kubectl --context %s -n %s get %s -o yaml",
+			serverVersion, config.OCPVersion,
+			config.GetKubeContext(), config.WorkloadClusterNamespace, controlPlaneResource)
 		return
 	}
 
-	t.Logf("OpenShift version:\n%s", output)
+	PrintToTTY("✅ OpenShift %s matches OCP_VERSION %s\n\n", serverVersion, config.OCPVersion)
 }
 
 // TestVerification_ClusterOperators checks cluster operators status
 func TestVerification_ClusterOperators(t *testing.T) {
+	TrackPhaseTiming(t)
 
 	config := NewTestConfig()
-	kubeconfigPath := getKubeconfigPath(config)
+	skipIfVerifyParallel(t, config)
 
-	if !FileExists(kubeconfigPath) {
-		t.Skipf("Kubeconfig not available at %s, run TestVerification_RetrieveKubeconfig first", kubeconfigPath)
-	}
+	ForEachCluster(t, config, clusterOperatorsForCluster)
+}
 
-	t.Log("Checking cluster operators...")
+// clusterOperatorsForCluster is TestVerification_ClusterOperators for a single workload cluster.
+func clusterOperatorsForCluster(t *testing.T, config *TestConfig) {
+	requireWorkloadKubeconfig(t, config)
 
-	SetEnvVar(t, "KUBECONFIG", kubeconfigPath)
+	t.Log("Checking cluster operators...")
 
-	output, err := RunCommand(t, "oc", "get", "clusteroperators")
+	output, err := RunCommand(t, "oc", workloadClusterArgs(config, "get", "clusteroperators")...)
 	if err != nil {
 		t.Logf("Failed to get cluster operators (cluster may still be provisioning): %v\nOutput: %s", err, output)
 		return
@@ -300,20 +397,22 @@ func TestVerification_ClusterOperators(t *testing.T) {
 
 // TestVerification_ClusterHealth performs basic health checks
 func TestVerification_ClusterHealth(t *testing.T) {
+	TrackPhaseTiming(t)
 
 	config := NewTestConfig()
-	kubeconfigPath := getKubeconfigPath(config)
+	skipIfVerifyParallel(t, config)
 
-	if !FileExists(kubeconfigPath) {
-		t.Skipf("Kubeconfig not available at %s, run TestVerification_RetrieveKubeconfig first", kubeconfigPath)
-	}
+	ForEachCluster(t, config, clusterHealthForCluster)
+}
 
-	SetEnvVar(t, "KUBECONFIG", kubeconfigPath)
+// clusterHealthForCluster is TestVerification_ClusterHealth for a single workload cluster.
+func clusterHealthForCluster(t *testing.T, config *TestConfig) {
+	requireWorkloadKubeconfig(t, config)
 
 	// Check pods in kube-system namespace
 	t.Log("Checking system pods...")
 
-	output, err := RunCommand(t, "kubectl", "get", "pods", "-n", "kube-system")
+	output, err := RunCommand(t, "kubectl", workloadClusterArgs(config, "get", "pods", "-n", "kube-system")...)
 	if err != nil {
 		t.Logf("Failed to get system pods: %v\nOutput: %s", err, output)
 	} else {
@@ -321,7 +420,8 @@ func TestVerification_ClusterHealth(t *testing.T) {
 	}
 
 	// Check for any failing pods
-	output, err = RunCommand(t, "kubectl", "get", "pods", "-A", "--field-selector=status.phase!=Running,status.phase!=Succeeded")
+	output, err = RunCommand(t, "kubectl", workloadClusterArgs(config, "get", "pods", "-A",
+		"--field-selector=status.phase!=Running,status.phase!=Succeeded")...)
 	if err == nil && strings.TrimSpace(output) != "" {
 		lines := strings.Split(output, "\n")
 		if len(lines) > 1 { // More than just header
@@ -332,6 +432,171 @@ func TestVerification_ClusterHealth(t *testing.T) {
 	}
 }
 
+// verificationCheck is one read-only workload cluster check TestVerification_AllChecks runs.
+type verificationCheck struct {
+	name string
+	run  func(t *testing.T, config *TestConfig)
+}
+
+// verificationChecks returns the checks that only read the workload cluster and do not depend
+// on each other. They reach the workload cluster through workloadClusterArgs and never set
+// KUBECONFIG, so they can run concurrently. Each check covers every cluster in the ClusterSet.
+func verificationChecks() []verificationCheck {
+	forEachCluster := func(fn func(t *testing.T, cluster *TestConfig)) func(t *testing.T, config *TestConfig) {
+		return func(t *testing.T, config *TestConfig) { ForEachCluster(t, config, fn) }
+	}
+	return []verificationCheck{
+		{name: "ClusterNodes", run: forEachCluster(clusterNodesForCluster)},
+		{name: "ClusterVersion", run: forEachCluster(clusterVersionForCluster)},
+		{name: "ClusterOperators", run: forEachCluster(clusterOperatorsForCluster)},
+		{name: "ClusterHealth", run: forEachCluster(clusterHealthForCluster)},
+	}
+}
+
+// skipIfVerifyParallel skips one of the verificationChecks tests when VERIFY_PARALLELISM is set,
+// since TestVerification_AllChecks runs it instead.
+func skipIfVerifyParallel(t *testing.T, config *TestConfig) {
+	t.Helper()
+	if config.VerifyParallelism > 0 {
+		t.Skipf("VERIFY_PARALLELISM=%d: run by TestVerification_AllChecks", config.VerifyParallelism)
+	}
+}
+
+// TestVerification_AllChecks runs verificationChecks as parallel sub-tests, VERIFY_PARALLELISM
+// at a time, so verification waits for the slowest check instead of the sum of all of them.
+// Each check reports its own failures; the summary lists every failed check so one flaky check
+// does not hide the others. Skipped unless VERIFY_PARALLELISM is set.
+func TestVerification_AllChecks(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+	if config.VerifyParallelism == 0 {
+		t.Skip("VERIFY_PARALLELISM is not set, checks run as separate tests")
+	}
+
+	checks := verificationChecks()
+	PrintTestHeader(t, "TestVerification_AllChecks",
+		fmt.Sprintf("Run %d verification checks, %d at a time", len(checks), config.VerifyParallelism))
+
+	// Set KUBECONFIG once, before any check starts; the checks must not change it while
+	// others are running
+	if config.IsExternalCluster() {
+		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
+	}
+
+	var mu sync.Mutex
+	results := make(map[string]string, len(checks))
+	slots := make(chan struct{}, config.VerifyParallelism)
+	startTime := time.Now()
+
+	// The group returns only when every parallel check has finished
+	t.Run("checks", func(t *testing.T) {
+		for _, check := range checks {
+			t.Run(check.name, func(t *testing.T) {
+				t.Parallel()
+				slots <- struct{}{}
+				defer func() { <-slots }()
+
+				t.Cleanup(func() {
+					result := "passed"
+					if t.Failed() {
+						result = "failed"
+					} else if t.Skipped() {
+						result = "skipped"
+					}
+					mu.Lock()
+					results[check.name] = result
+					mu.Unlock()
+				})
+				check.run(t, config)
+			})
+		}
+	})
+
+	var failed []string
+	PrintToTTY("\n=== Verification checks (took %v) ===\n", time.Since(startTime).Round(time.Second))
+	for _, check := range checks {
+		result := results[check.name]
+		icon := "✅"
+		switch result {
+		case "failed":
+			icon = "❌"
+			failed = append(failed, check.name)
+		case "skipped":
+			icon = "⚠️ "
+		}
+		PrintToTTY("%s %s: %s\n", icon, check.name, result)
+		t.Logf("%s: %s", check.name, result)
+	}
+	PrintToTTY("\n")
+
+	if len(failed) > 0 {
+		t.Errorf("%d of %d verification checks failed: %s\n\n"+
+			"To fix this:\n"+
+			"  1. See each failed check's sub-test output above for details\n"+
+			"  2. Rerun one check on its own with VERIFY_PARALLELISM unset: go test -v ./test -run TestVerification_%s",
+			len(failed), len(checks), strings.Join(failed, ", "), failed[0])
+	}
+}
+
+// TestVerification_ConsoleReachable verifies the workload cluster's web console answers over
+// HTTPS, confirming the cluster is usable by people and not just through the API server.
+// Set SKIP_CONSOLE_CHECK=true where the cluster's ingress is not reachable from the test host.
+func TestVerification_ConsoleReachable(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+	if config.SkipConsoleCheck {
+		t.Skip("Console check disabled (SKIP_CONSOLE_CHECK=true)")
+	}
+
+	ForEachCluster(t, config, consoleReachableForCluster)
+}
+
+// consoleReachableForCluster is TestVerification_ConsoleReachable for a single workload cluster.
+func consoleReachableForCluster(t *testing.T, config *TestConfig) {
+	kubeconfigPath := requireWorkloadKubeconfig(t, config)
+
+	PrintTestHeader(t, "TestVerification_ConsoleReachable",
+		"Check the OpenShift web console route answers over HTTPS")
+
+	// The console can answer 503 briefly while its pods roll out after install
+	timeout := 5 * time.Minute
+	pollInterval := 15 * time.Second
+
+	var check ConsoleCheck
+	err := PollUntil(RunContext(), t, timeout, pollInterval, func() (bool, string, error) {
+		var checkErr error
+		check, checkErr = CheckConsoleReachable(t, workloadClusterArgs(config), config.ProxySettings())
+		if errors.Is(checkErr, ErrConsoleRouteNotFound) {
+			return false, "", checkErr
+		}
+		if checkErr != nil {
+			return false, checkErr.Error(), nil
+		}
+		return true, "", nil
+	})
+	if errors.Is(err, ErrConsoleRouteNotFound) {
+		PrintToTTY("⏭️  No console route in openshift-console\n\n")
+		t.Skipf("No web console route on this cluster: %v", err)
+	}
+	if err != nil {
+		PrintToTTY("❌ Console not reachable: %v\n\n", err)
+		t.Errorf("Web console not reachable: %v\n\n"+
+			"Troubleshooting steps:\n"+
+			"  1. Check the route: oc --kubeconfig %s --context %s get route console -n openshift-console\n"+
+			"  2. Check the console operator: oc --kubeconfig %s --context %s get clusteroperator console ingress\n"+
+			"  3. If the cluster ingress is private or unreachable from this host, set SKIP_CONSOLE_CHECK=true",
+			err,
+			kubeconfigPath, config.GetProvisionedClusterName(),
+			kubeconfigPath, config.GetProvisionedClusterName())
+		return
+	}
+
+	PrintToTTY("✅ Console reachable: %s (HTTP %d)\n\n", check.URL, check.StatusCode)
+	t.Logf("Web console %s answered HTTP %d", check.URL, check.StatusCode)
+}
+
 // TestVerification_TestedVersionsSummary displays a summary of all tested component versions.
 // This test collects version information from the management cluster for CAPZ, ASO, CAPI,
 // and other infrastructure components, providing a clear summary at the end of testing.
@@ -440,8 +705,8 @@ func TestVerification_ControllerLogSummary(t *testing.T) {
 	for _, s := range summaries {
 		totalErrors += s.ErrorCount
 		totalWarnings += s.WarnCount
-		t.Logf("Controller %s: %d errors, %d warnings (log: %s)",
-			s.Name, s.ErrorCount, s.WarnCount, s.LogFile)
+		t.Logf("Controller %s: %d errors (%d raw), %d warnings (log: %s)",
+			s.Name, s.ErrorCount, s.RawErrorCount, s.WarnCount, s.LogFile)
 	}
 
 	// Log summary to test output
@@ -454,4 +719,184 @@ func TestVerification_ControllerLogSummary(t *testing.T) {
 	}
 
 	t.Logf("Controller logs saved to: %s", resultsDir)
+
+	// Reconcile error counters are more precise than log grepping; report them alongside
+	for _, m := range scrapeControllerMetrics(t, config, context) {
+		if m.Spike() {
+			t.Logf("Warning: %s reconcile error spike: %.0f of %.0f reconciles failed", m.Name, m.ReconcileErrors, m.Reconciles)
+		}
+	}
+
+	// Strict pipelines treat controller errors as regressions
+	if config.FailOnControllerErrors && totalErrors > config.ControllerErrorThreshold {
+		PrintToTTY("❌ Controllers logged %d errors (threshold: %d)\n\n", totalErrors, config.ControllerErrorThreshold)
+		t.Fatalf("Controllers logged %d errors, above the threshold of %d (FAIL_ON_CONTROLLER_ERRORS=true):\n%s\n\n"+
+			"Review the saved logs in %s.\n"+
+			"To tolerate known-benign errors, list patterns in a file referenced by CONTROLLER_ERROR_ALLOWLIST\n"+
+			"or set CONTROLLER_ERROR_THRESHOLD=<n>; unset FAIL_ON_CONTROLLER_ERRORS to make this informational.",
+			totalErrors, config.ControllerErrorThreshold, FormatControllerErrorBreakdown(summaries), resultsDir)
+	}
+}
+
+// scrapeControllerMetrics scrapes the reconcile counters of every controller and prints them
+// as part of the controller summary. Controllers whose metrics cannot be scraped are listed
+// with the reason; scraping never fails the test.
+func scrapeControllerMetrics(t *testing.T, config *TestConfig, context string) []ControllerMetrics {
+	t.Helper()
+
+	var metrics []ControllerMetrics
+	for _, ctrl := range config.AllControllers() {
+		m, err := ScrapeControllerMetrics(t, context, ctrl)
+		if err != nil {
+			t.Logf("Warning: could not scrape %s metrics: %v", ctrl.DisplayName, err)
+			m = ControllerMetrics{Name: ctrl.DisplayName, ScrapeError: err.Error()}
+		}
+		metrics = append(metrics, m)
+	}
+
+	metricsStr := FormatControllerMetrics(metrics)
+	PrintToTTY("%s", metricsStr)
+	t.Log(metricsStr)
+	return metrics
+}
+
+// TestVerification_CollectEvents saves the event history of the workload cluster namespace on the
+// management cluster and of kube-system on the workload cluster, so it is available alongside the
+// controller logs when a run needs investigating. Collection problems never fail the run.
+func TestVerification_CollectEvents(t *testing.T) {
+
+	config := NewTestConfig()
+
+	// Set KUBECONFIG for external cluster mode (management cluster)
+	if config.IsExternalCluster() {
+		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
+	}
+
+	context := config.GetKubeContext()
+
+	PrintTestHeader(t, "TestVerification_CollectEvents",
+		"Save events from the management and workload clusters")
+
+	resultsDir := GetResultsDir()
+
+	path, err := CollectEvents(t, []string{"--context", context}, config.WorkloadClusterNamespace, resultsDir)
+	if err != nil {
+		PrintToTTY("⚠️  Could not collect management cluster events: %v\n", err)
+		t.Logf("Warning: failed to collect management cluster events: %v", err)
+	} else {
+		PrintToTTY("📄 Management cluster events (%s): %s\n", config.WorkloadClusterNamespace, path)
+		t.Logf("Management cluster events saved to %s", path)
+	}
+
+	kubeconfigPath := getKubeconfigPath(config)
+	if !FileExists(kubeconfigPath) {
+		PrintToTTY("⏭️  Workload cluster events skipped: kubeconfig not available at %s\n\n", kubeconfigPath)
+		t.Logf("Workload cluster kubeconfig not available at %s, skipping workload events", kubeconfigPath)
+		return
+	}
+
+	path, err = CollectEvents(t, workloadClusterArgs(config), "kube-system", resultsDir)
+	if err != nil {
+		PrintToTTY("⚠️  Could not collect workload cluster events: %v\n\n", err)
+		t.Logf("Warning: failed to collect workload cluster events: %v", err)
+		return
+	}
+	PrintToTTY("📄 Workload cluster events (kube-system): %s\n\n", path)
+	t.Logf("Workload cluster events saved to %s", path)
+}
+
+// TestVerification_GenerateReport writes a consolidated report.md and report.json to the results
+// directory, combining component versions, final cluster conditions, node status, and controller
+// log error/warning counts. It runs last in the phase so it links the controller logs saved by
+// TestVerification_ControllerLogSummary. Reporting problems never fail the run.
+func TestVerification_GenerateReport(t *testing.T) {
+
+	config := NewTestConfig()
+
+	// Set KUBECONFIG for external cluster mode
+	if config.IsExternalCluster() {
+		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
+	}
+
+	PrintTestHeader(t, "TestVerification_GenerateReport",
+		"Write consolidated run report (versions, conditions, nodes, controller logs)")
+
+	resultsDir := GetResultsDir()
+	report, mdPath, err := GenerateRunReport(t, config, resultsDir)
+	if err != nil {
+		t.Logf("Warning: failed to write run report: %v", err)
+		return
+	}
+
+	if report.MonitorError != "" {
+		t.Logf("Cluster status unavailable in report: %s", report.MonitorError)
+	}
+	PrintToTTY("\n📄 Run report written to: %s\n\n", mdPath)
+	t.Logf("Run report written to %s (and %s)", mdPath, RunReportJSONFileName)
+}
+
+// TestVerification_NoSecretLeak scans the results directory for subscription IDs and base64
+// blobs that may be leaked credentials. It runs after everything else in the phase has written
+// its results. Findings are warnings unless REDACT_STRICT=1, which makes them fail the run.
+func TestVerification_NoSecretLeak(t *testing.T) {
+	TrackPhaseTiming(t)
+
+	config := NewTestConfig()
+
+	PrintTestHeader(t, "TestVerification_NoSecretLeak",
+		"Scan results files for leaked subscription IDs and secret blobs")
+
+	resultsDir := GetResultsDir()
+	findings, err := ScanForSecrets(resultsDir, nil)
+	if err != nil {
+		PrintToTTY("⚠️  Could not scan all results files: %v\n", err)
+		t.Logf("Warning: results scan incomplete: %v", err)
+	}
+
+	if len(findings) == 0 {
+		PrintToTTY("✅ No secret-looking values in %s\n\n", resultsDir)
+		t.Logf("No secret-looking values found in %s", resultsDir)
+		return
+	}
+
+	var lines []string
+	for _, f := range findings {
+		lines = append(lines, "  "+f.String())
+	}
+	msg := fmt.Sprintf("%d secret-looking value(s) in %s:\n%s", len(findings), resultsDir, strings.Join(lines, "\n"))
+	if !config.RedactStrict {
+		PrintToTTY("⚠️  %s\n\n", msg)
+		t.Logf("Warning: %s (set REDACT_STRICT=1 to fail on this)", msg)
+		return
+	}
+
+	PrintToTTY("❌ %s\n\n", msg)
+	t.Errorf("%s\n\n"+
+		"To fix this:\n"+
+		"  1. Find the helper that wrote each file and redact the value before writing it\n"+
+		"  2. Subscription IDs often come from ARM resource IDs or node provider IDs\n"+
+		"  3. If a value is not a secret, unset REDACT_STRICT for this run", msg)
+}
+
+// verificationPhaseSteps returns this file's tests in the order they must run.
+func verificationPhaseSteps() []e2eStep {
+	return []e2eStep{
+		{name: "RetrieveKubeconfig", run: TestVerification_RetrieveKubeconfig},
+		{name: "ClusterNodes", run: TestVerification_ClusterNodes},
+		{name: "ClusterVersion", run: TestVerification_ClusterVersion},
+		{name: "ClusterOperators", run: TestVerification_ClusterOperators},
+		{name: "ClusterHealth", run: TestVerification_ClusterHealth},
+		{name: "AllChecks", run: TestVerification_AllChecks},
+		{name: "ConsoleReachable", run: TestVerification_ConsoleReachable},
+		{name: "TestedVersionsSummary", run: TestVerification_TestedVersionsSummary},
+		{name: "ControllerLogSummary", run: TestVerification_ControllerLogSummary},
+		{name: "CollectEvents", run: TestVerification_CollectEvents},
+		{name: "GenerateReport", run: TestVerification_GenerateReport},
+		{name: "NoSecretLeak", run: TestVerification_NoSecretLeak},
+	}
+}
+
+// TestVerification_ZZZ_RunPhase runs verificationPhaseSteps in order via runOrderedPhase.
+func TestVerification_ZZZ_RunPhase(t *testing.T) {
+	runOrderedPhase(t, verificationPhaseSteps())
 }
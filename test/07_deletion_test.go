@@ -16,12 +16,18 @@ func TestDeletion_DeleteCluster(t *testing.T) {
 		t.Fatalf("Configuration initialization failed: %s", *configError)
 	}
 
-	config := NewTestConfig()
+	CheckToolsForPhase(t, "delete")
+
+	ForEachCluster(t, NewTestConfig(), deleteClusterForCluster)
+}
 
+// deleteClusterForCluster is TestDeletion_DeleteCluster for a single workload cluster.
+func deleteClusterForCluster(t *testing.T, config *TestConfig) {
 	// Set KUBECONFIG for external cluster mode
 	if config.IsExternalCluster() {
 		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
 	}
+	RequireManagementClusterReachable(t, config)
 
 	context := config.GetKubeContext()
 
@@ -40,6 +46,17 @@ func TestDeletion_DeleteCluster(t *testing.T) {
 	}
 
 	PrintToTTY("📋 Cluster '%s' found in namespace '%s'\n", provisionedClusterName, config.WorkloadClusterNamespace)
+
+	// Snapshot the resources before anything is deleted, so a stuck deletion can be compared
+	// against the starting state. Snapshot problems never block the deletion.
+	if path, err := SnapshotCAPIResources(t, context, config.WorkloadClusterNamespace, GetResultsDir()); err != nil {
+		PrintToTTY("⚠️  Could not snapshot resources before deletion: %v\n", err)
+		t.Logf("Warning: failed to snapshot CAPI resources: %v", err)
+	} else {
+		PrintToTTY("📄 Pre-deletion resource snapshot: %s\n", path)
+		t.Logf("Pre-deletion resource snapshot saved to %s", path)
+	}
+
 	PrintToTTY("🗑️  Initiating cluster deletion...\n\n")
 	t.Logf("Deleting cluster '%s' from namespace '%s'", provisionedClusterName, config.WorkloadClusterNamespace)
 
@@ -61,8 +78,7 @@ func TestDeletion_DeleteCluster(t *testing.T) {
 				PrintToTTY("🗑️  Deleting ROSAControlPlane '%s' first...\n", controlPlaneName)
 				t.Logf("Deleting ROSAControlPlane '%s' before cluster", controlPlaneName)
 
-				cpOutput, err := RunCommand(t, "kubectl", "--context", context, "-n", config.WorkloadClusterNamespace,
-					"delete", "rosacontrolplane", controlPlaneName, "--wait=false")
+				cpOutput, err := ExecKubeClient{}.Delete(t, context, config.WorkloadClusterNamespace, "rosacontrolplane", controlPlaneName)
 				if err != nil {
 					PrintToTTY("⚠️  Failed to delete ROSAControlPlane: %v\n", err)
 					t.Logf("Warning: Failed to delete ROSAControlPlane: %v\nOutput: %s", err, cpOutput)
@@ -77,8 +93,7 @@ func TestDeletion_DeleteCluster(t *testing.T) {
 	// Delete the cluster resource - this triggers cascading deletion of all related resources
 	// Use --wait=false to return immediately so the next test can monitor deletion progress
 	PrintToTTY("🗑️  Deleting Cluster resource...\n")
-	output, err := RunCommand(t, "kubectl", "--context", context, "-n", config.WorkloadClusterNamespace,
-		"delete", "cluster", provisionedClusterName, "--wait=false")
+	output, err := ExecKubeClient{}.Delete(t, context, config.WorkloadClusterNamespace, "cluster", provisionedClusterName)
 	if err != nil {
 		PrintToTTY("❌ Failed to delete cluster: %v\n", err)
 		PrintToTTY("Output: %s\n\n", output)
@@ -94,12 +109,16 @@ func TestDeletion_DeleteCluster(t *testing.T) {
 // This monitors the cluster resource until it no longer exists, showing detailed
 // progress information about all resources being deleted.
 func TestDeletion_WaitForClusterDeletion(t *testing.T) {
-	config := NewTestConfig()
+	ForEachCluster(t, NewTestConfig(), waitForClusterDeletionForCluster)
+}
 
+// waitForClusterDeletionForCluster is TestDeletion_WaitForClusterDeletion for a single workload cluster.
+func waitForClusterDeletionForCluster(t *testing.T, config *TestConfig) {
 	// Set KUBECONFIG for external cluster mode
 	if config.IsExternalCluster() {
 		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
 	}
+	RequireManagementClusterReachable(t, config)
 
 	context := config.GetKubeContext()
 
@@ -116,122 +135,50 @@ func TestDeletion_WaitForClusterDeletion(t *testing.T) {
 		"Wait for cluster resource to be fully deleted")
 
 	timeout := config.ClusterDeletionTimeout
-	pollInterval := 30 * time.Second
+	backoff := config.DeletionPollBackoff()
 	startTime := time.Now()
 
 	PrintToTTY("⏳ Waiting for cluster '%s' to be deleted...\n", provisionedClusterName)
-	PrintToTTY("Namespace: %s | Timeout: %v | Poll interval: %v\n", config.WorkloadClusterNamespace, timeout, pollInterval)
+	PrintToTTY("Namespace: %s | Timeout: %v | Poll interval: %v\n", config.WorkloadClusterNamespace, timeout, backoff)
 	if resourceGroup != "" {
 		PrintToTTY("Azure Resource Group: %s\n", resourceGroup)
 	}
+	if deadlines := formatDeletionDeadlines(config.DeletionDeadlines); deadlines != "" {
+		PrintToTTY("Per-type deadlines: %s\n", deadlines)
+	}
 	PrintToTTY("\n")
 	t.Logf("Waiting for cluster '%s' deletion (namespace: %s, timeout: %v)...", provisionedClusterName, config.WorkloadClusterNamespace, timeout)
 
 	// Resolve clusterctl for diagnostics during deletion monitoring
 	clusterctlPath, hasClusterctl := ResolveClusterctlPath(config)
+	if hasClusterctl && config.ClusterctlSHA256 != "" {
+		// Diagnostics only: an unverified binary is not run, but deletion still proceeds
+		if err := VerifyBinaryChecksum(clusterctlPath, config.ClusterctlSHA256); err != nil {
+			PrintToTTY("⚠️  clusterctl failed its integrity check, not using it: %v\n", err)
+			t.Logf("Warning: clusterctl integrity check failed, skipping clusterctl diagnostics: %v", err)
+			hasClusterctl = false
+		}
+	}
 	if hasClusterctl {
 		PrintToTTY("📊 clusterctl available for deletion diagnostics: %s\n\n", clusterctlPath)
 	} else {
 		PrintToTTY("ℹ️  clusterctl not available — skipping clusterctl diagnostics\n\n")
 	}
 
-	var lastStatus DeletionResourceStatus
-	iteration := 0
-	for {
-		elapsed := time.Since(startTime)
-		remaining := timeout - elapsed
-
-		if elapsed > timeout {
-			PrintToTTY("\n❌ Timeout waiting for cluster deletion after %v\n\n", elapsed.Round(time.Second))
-
-			// === Diagnostic dump on timeout ===
-			PrintToTTY("=== DELETION TIMEOUT DIAGNOSTICS ===\n\n")
-			t.Logf("=== Deletion timeout diagnostics ===")
-
-			// 1. clusterctl describe
-			if hasClusterctl {
-				PrintToTTY("--- clusterctl describe (timeout snapshot) ---\n")
-				clOutput, clErr := RunCommandQuiet(t, clusterctlPath, "describe", "cluster",
-					provisionedClusterName, "-n", config.WorkloadClusterNamespace, "--show-conditions=all")
-				if clErr == nil {
-					PrintToTTY("%s\n", clOutput)
-					t.Logf("clusterctl describe at timeout:\n%s", clOutput)
-				} else {
-					PrintToTTY("clusterctl describe failed: %v\n\n", clErr)
-					t.Logf("clusterctl describe at timeout failed: %v", clErr)
-				}
-			}
-
-			// 2. Controller log summaries + save to results dir
-			PrintToTTY("--- Controller logs (timeout snapshot) ---\n")
-			summaries := GetAllControllerLogSummaries(t, context)
-			resultsDir := GetResultsDir()
-			summaries = SaveAllControllerLogs(t, context, resultsDir, summaries)
-			PrintToTTY("%s", FormatControllerLogSummaries(summaries))
-			t.Logf("Controller logs saved to %s", resultsDir)
-
-			// 3. Finalizer details (reuse from last GetDeletionResourceStatus call)
-			if len(lastStatus.ClusterFinalizers) > 0 {
-				PrintToTTY("--- Active finalizers ---\n%s\n\n", strings.Join(lastStatus.ClusterFinalizers, ", "))
-				t.Logf("Active finalizers at timeout: %s", strings.Join(lastStatus.ClusterFinalizers, ", "))
-			}
-
-			PrintToTTY("=== END DIAGNOSTICS ===\n\n")
-
-			// Build provider-agnostic troubleshooting message
-			controlPlaneResource := "controlplane"
-			cleanupCommand := "make clean"
-			additionalSteps := ""
-
-			if config.HasProvider("aro") {
-				controlPlaneResource = "arocontrolplane"
-				cleanupCommand = "make clean-azure"
-				if resourceGroup != "" {
-					additionalSteps = fmt.Sprintf("  4. Check Azure resource group: az group show --name %s 2>/dev/null\n", resourceGroup)
-				}
-			} else if config.HasProvider("rosa") {
-				controlPlaneResource = "rosacontrolplane"
-			}
-
-			t.Errorf("Timeout waiting for cluster '%s' to be deleted after %v.\n\n"+
-				"Troubleshooting steps:\n"+
-				"  1. Check cluster status: kubectl --context %s -n %s get cluster %s -o yaml\n"+
-				"  2. Check for stuck finalizers: kubectl --context %s -n %s get cluster %s -o jsonpath='{.metadata.finalizers}'\n"+
-				"  3. Check remaining CAPI resources: kubectl --context %s -n %s get %s,machinepool\n"+
-				"%s\n"+
-				"Common causes:\n"+
-				"  - Cloud resource deletion taking longer than expected\n"+
-				"  - Finalizers blocking resource deletion\n"+
-				"  - Cloud resources stuck in 'Deleting' state\n\n"+
-				"To increase timeout: export DEPLOYMENT_TIMEOUT=60m\n"+
-				"To manually clean up:\n"+
-				"  %s",
-				provisionedClusterName, elapsed.Round(time.Second),
-				context, config.WorkloadClusterNamespace, provisionedClusterName,
-				context, config.WorkloadClusterNamespace, provisionedClusterName,
-				context, config.WorkloadClusterNamespace, controlPlaneResource,
-				additionalSteps,
-				cleanupCommand)
-			return
-		}
-
-		iteration++
+	// Time each resource type separately so one that overruns its own deadline is flagged
+	// while the overall wait is still running
+	tracker := NewDeletionTracker(startTime, config.DeletionDeadlines)
 
+	var lastStatus DeletionResourceStatus
+	err := PollUntilWithBackoff(RunContext(), t, timeout, backoff, func() (bool, string, error) {
 		// Get comprehensive deletion status
-		lastStatus = GetDeletionResourceStatus(t, context, config.WorkloadClusterNamespace, provisionedClusterName, resourceGroup)
-
-		// Check if cluster is fully deleted
+		lastStatus = GetDeletionResourceStatus(t, ExecKubeClient{}, context, config.WorkloadClusterNamespace, provisionedClusterName, resourceGroup)
+		reportDeletionDeadlines(t, tracker, lastStatus)
 		if !lastStatus.ClusterExists {
-			PrintToTTY("\n✅ Cluster '%s' has been deleted (took %v)\n\n", provisionedClusterName, elapsed.Round(time.Second))
-			t.Logf("Cluster '%s' deleted successfully (took %v)", provisionedClusterName, elapsed.Round(time.Second))
-
-			// Show final status
-			PrintToTTY("%s", FormatDeletionProgress(lastStatus))
-			return
+			return true, "", nil
 		}
 
-		// Report detailed deletion progress
-		ReportDeletionProgress(t, iteration, elapsed, remaining, lastStatus)
+		PrintToTTY("\n%s", FormatDeletionProgress(lastStatus))
 
 		// clusterctl describe on every iteration for live CAPI resource tree
 		if hasClusterctl {
@@ -245,8 +192,128 @@ func TestDeletion_WaitForClusterDeletion(t *testing.T) {
 			}
 		}
 
-		time.Sleep(pollInterval)
+		return false, FormatDeletionStatusLine(lastStatus), nil
+	})
+	if err != nil {
+		// === Diagnostic dump on timeout or interrupt ===
+		PrintToTTY("=== DELETION %s DIAGNOSTICS ===\n\n", strings.ToUpper(PollErrorPrefix(err)))
+		t.Logf("=== Deletion %s diagnostics ===", strings.ToLower(PollErrorPrefix(err)))
+
+		// 1. clusterctl describe
+		if hasClusterctl {
+			PrintToTTY("--- clusterctl describe (timeout snapshot) ---\n")
+			clOutput, clErr := RunCommandQuiet(t, clusterctlPath, "describe", "cluster",
+				provisionedClusterName, "-n", config.WorkloadClusterNamespace, "--show-conditions=all")
+			if clErr == nil {
+				PrintToTTY("%s\n", clOutput)
+				t.Logf("clusterctl describe at timeout:\n%s", clOutput)
+			} else {
+				PrintToTTY("clusterctl describe failed: %v\n\n", clErr)
+				t.Logf("clusterctl describe at timeout failed: %v", clErr)
+			}
+		}
+
+		// 2. Controller log summaries + save to results dir
+		PrintToTTY("--- Controller logs (timeout snapshot) ---\n")
+		summaries := GetAllControllerLogSummaries(t, context)
+		resultsDir := GetResultsDir()
+		summaries = SaveAllControllerLogs(t, context, resultsDir, summaries)
+		PrintToTTY("%s", FormatControllerLogSummaries(summaries))
+		t.Logf("Controller logs saved to %s", resultsDir)
+
+		// 3. Finalizer details (reuse from last GetDeletionResourceStatus call)
+		if len(lastStatus.ClusterFinalizers) > 0 {
+			PrintToTTY("--- Active finalizers ---\n%s\n\n", strings.Join(lastStatus.ClusterFinalizers, ", "))
+			t.Logf("Active finalizers at timeout: %s", strings.Join(lastStatus.ClusterFinalizers, ", "))
+		}
+
+		// 4. Per-type deletion timings
+		PrintToTTY("--- Deletion timings ---\n%s\n\n", strings.Join(tracker.Summary(time.Now()), "\n"))
+		t.Logf("Deletion timings at timeout:\n%s", strings.Join(tracker.Summary(time.Now()), "\n"))
+
+		PrintToTTY("=== END DIAGNOSTICS ===\n\n")
+
+		// Build provider-agnostic troubleshooting message
+		controlPlaneResource := "controlplane"
+		cleanupCommand := "make clean"
+		additionalSteps := ""
+
+		if config.HasProvider("aro") {
+			controlPlaneResource = "arocontrolplane"
+			cleanupCommand = "make clean-azure"
+			if resourceGroup != "" {
+				additionalSteps = fmt.Sprintf("  4. Check Azure resource group: az group show --name %s 2>/dev/null\n", resourceGroup)
+			}
+		} else if config.HasProvider("rosa") {
+			controlPlaneResource = "rosacontrolplane"
+		}
+
+		t.Errorf("%s waiting for cluster '%s' to be deleted: %v\n\n"+
+			"Troubleshooting steps:\n"+
+			"  1. Check cluster status: kubectl --context %s -n %s get cluster %s -o yaml\n"+
+			"  2. Check for stuck finalizers: kubectl --context %s -n %s get cluster %s -o jsonpath='{.metadata.finalizers}'\n"+
+			"  3. Check remaining CAPI resources: kubectl --context %s -n %s get %s,machinepool\n"+
+			"%s\n"+
+			"Common causes:\n"+
+			"  - Cloud resource deletion taking longer than expected\n"+
+			"  - Finalizers blocking resource deletion\n"+
+			"  - Cloud resources stuck in 'Deleting' state\n\n"+
+			"To increase timeout: export DEPLOYMENT_TIMEOUT=60m\n"+
+			"To manually clean up:\n"+
+			"  %s",
+			PollErrorPrefix(err), provisionedClusterName, err,
+			context, config.WorkloadClusterNamespace, provisionedClusterName,
+			context, config.WorkloadClusterNamespace, provisionedClusterName,
+			context, config.WorkloadClusterNamespace, controlPlaneResource,
+			additionalSteps,
+			cleanupCommand)
+		return
+	}
+
+	elapsed := time.Since(startTime)
+	PrintToTTY("\n✅ Cluster '%s' has been deleted (took %v)\n\n", provisionedClusterName, elapsed.Round(time.Second))
+	t.Logf("Cluster '%s' deleted successfully (took %v)", provisionedClusterName, elapsed.Round(time.Second))
+
+	// Show final status
+	PrintToTTY("%s", FormatDeletionProgress(lastStatus))
+
+	timings := strings.Join(tracker.Summary(time.Now()), "\n")
+	PrintToTTY("Deletion timings:\n%s\n\n", timings)
+	t.Logf("Deletion timings:\n%s", timings)
+}
+
+// reportDeletionDeadlines feeds status to tracker, printing each resource type as it is deleted
+// and failing the test (without stopping the wait) for a type that passes its deadline.
+func reportDeletionDeadlines(t *testing.T, tracker *DeletionTracker, status DeletionResourceStatus) {
+	t.Helper()
+
+	now := time.Now()
+	deleted, overdue := tracker.Observe(status, now)
+	for _, typ := range deleted {
+		PrintToTTY("✅ %s deleted\n", tracker.Label(typ))
+		t.Logf("%s deleted", tracker.Label(typ))
+	}
+	for _, typ := range overdue {
+		PrintToTTY("❌ %s still present after its %v deadline\n", tracker.Label(typ), tracker.Deadline(typ))
+		t.Errorf("%s was not deleted within its %v deadline (DELETION_DEADLINES); still waiting for the rest of the cluster\n\n"+
+			"Deletion timings so far:\n  %s\n\n"+
+			"To fix this:\n"+
+			"  1. Check what is blocking it in the deletion progress and clusterctl describe output above\n"+
+			"  2. If this type is expected to take longer, raise its deadline, e.g. DELETION_DEADLINES=%s=%v",
+			tracker.Label(typ), tracker.Deadline(typ), strings.Join(tracker.Summary(now), "\n  "),
+			typ, 2*tracker.Deadline(typ))
+	}
+}
+
+// formatDeletionDeadlines renders deadlines as "MachinePool=20m0s, ..." in DeletionTypes order.
+func formatDeletionDeadlines(deadlines map[string]time.Duration) string {
+	var parts []string
+	for _, typ := range DeletionTypes {
+		if d := deadlines[typ]; d > 0 {
+			parts = append(parts, fmt.Sprintf("%s=%v", typ, d))
+		}
 	}
+	return strings.Join(parts, ", ")
 }
 
 // TestDeletion_VerifyControlPlaneDeletion verifies the control plane resource is deleted.
@@ -342,6 +409,8 @@ func TestDeletion_VerifyMachinePoolDeletion(t *testing.T) {
 
 // TestDeletion_VerifyAzureResourcesDeletion verifies Azure resources are cleaned up.
 // This checks if the Azure resource group still exists after cluster deletion.
+// With a pre-existing resource group (USE_EXISTING_RG), the group must remain and only
+// the cluster's resources are checked.
 // This test is ARO-specific and skipped for other providers.
 func TestDeletion_VerifyAzureResourcesDeletion(t *testing.T) {
 	config := NewTestConfig()
@@ -378,6 +447,11 @@ func TestDeletion_VerifyAzureResourcesDeletion(t *testing.T) {
 		// Resource group doesn't exist or we can't access it - this is expected after deletion
 		if strings.Contains(strings.ToLower(err.Error()), "not found") ||
 			strings.Contains(strings.ToLower(err.Error()), "could not be found") {
+			if rgErr := CheckResourceGroupAfterDeletion(resourceGroup, config.UseExistingRG, false); rgErr != nil {
+				PrintToTTY("❌ Pre-existing resource group '%s' was deleted\n\n", resourceGroup)
+				t.Errorf("%v", rgErr)
+				return
+			}
 			PrintToTTY("✅ Resource group '%s' has been deleted\n\n", resourceGroup)
 			t.Logf("Resource group '%s' has been deleted successfully", resourceGroup)
 			return
@@ -388,6 +462,30 @@ func TestDeletion_VerifyAzureResourcesDeletion(t *testing.T) {
 		return
 	}
 
+	if config.UseExistingRG {
+		// Pre-existing group is expected to remain; only the cluster's resources should be gone
+		PrintToTTY("✅ Pre-existing resource group '%s' retained\n", resourceGroup)
+		t.Logf("Pre-existing resource group '%s' retained (USE_EXISTING_RG)", resourceGroup)
+
+		remaining, listErr := ListClusterResourcesInGroup(t, resourceGroup, config.ClusterNamePrefix)
+		if listErr != nil {
+			PrintToTTY("⚠️  Could not list cluster resources: %v\n\n", listErr)
+			t.Logf("Warning: Could not list cluster resources in '%s': %v", resourceGroup, listErr)
+			return
+		}
+		if len(remaining) == 0 {
+			PrintToTTY("✅ No resources matching '%s' remain in the group\n\n", config.ClusterNamePrefix)
+			return
+		}
+		PrintToTTY("⚠️  %d cluster resource(s) matching '%s' remain:\n", len(remaining), config.ClusterNamePrefix)
+		for _, r := range remaining {
+			PrintToTTY("  - %s\n", r.Name)
+		}
+		PrintToTTY("\n")
+		t.Logf("Warning: %d cluster resource(s) still in pre-existing resource group '%s'", len(remaining), resourceGroup)
+		return
+	}
+
 	// Resource group still exists - check if it has any resources
 	PrintToTTY("⚠️  Resource group '%s' still exists\n", resourceGroup)
 	t.Logf("Warning: Resource group '%s' still exists after cluster deletion", resourceGroup)
@@ -403,6 +501,31 @@ func TestDeletion_VerifyAzureResourcesDeletion(t *testing.T) {
 	}
 }
 
+// TestDeletion_CollectEvents saves the event history of the workload cluster namespace before the
+// namespace (and its events) is deleted, so deletion problems can be investigated afterwards.
+// Collection problems never fail the run.
+func TestDeletion_CollectEvents(t *testing.T) {
+	config := NewTestConfig()
+
+	if config.IsExternalCluster() {
+		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
+	}
+
+	context := config.GetKubeContext()
+
+	PrintTestHeader(t, "TestDeletion_CollectEvents",
+		"Save workload cluster namespace events before the namespace is deleted")
+
+	path, err := CollectEvents(t, []string{"--context", context}, config.WorkloadClusterNamespace, GetResultsDir())
+	if err != nil {
+		PrintToTTY("⚠️  Could not collect events: %v\n\n", err)
+		t.Logf("Warning: failed to collect events: %v", err)
+		return
+	}
+	PrintToTTY("📄 Events (%s): %s\n\n", config.WorkloadClusterNamespace, path)
+	t.Logf("Events saved to %s", path)
+}
+
 // TestDeletion_DeleteManagementClusterK8sTestNamespace deletes the workload cluster namespace after all resources
 // have been deleted. Each test run creates a unique namespace (e.g., capz-test-20260202-135526)
 // that must be cleaned up to prevent namespace accumulation on the management cluster.
@@ -454,11 +577,33 @@ func TestDeletion_DeleteManagementClusterK8sTestNamespace(t *testing.T) {
 		}
 		PrintToTTY("❌ Failed to delete namespace: %v\n", err)
 		PrintToTTY("Output: %s\n\n", output)
-		t.Fatalf("Failed to delete namespace '%s': %v\nOutput: %s\n\n"+
+
+		// A namespace stuck in Terminating is almost always a leftover resource whose finalizer
+		// has not been released; name those resources instead of only pointing at the namespace
+		stuckDetails := ""
+		terminating, termErr := CheckNamespaceTerminating(t, context, config.WorkloadClusterNamespace)
+		switch {
+		case termErr != nil:
+			t.Logf("Warning: could not check whether namespace is terminating: %v", termErr)
+		case terminating && config.ForceDelete:
+			blockers, blockErr := GetFinalizerBlockers(t, context, config.WorkloadClusterNamespace)
+			if blockErr != nil {
+				stuckDetails = fmt.Sprintf("\nNamespace is stuck in Terminating; could not list blocking resources: %v\n", blockErr)
+			} else {
+				stuckDetails = "\nNamespace is stuck in Terminating.\n" +
+					FormatFinalizerBlockers(blockers, context, config.WorkloadClusterNamespace)
+			}
+			PrintToTTY("%s\n", stuckDetails)
+		case terminating:
+			stuckDetails = "\nNamespace is stuck in Terminating. Re-run with FORCE_DELETE=true to list the resources holding finalizers.\n"
+			PrintToTTY("⚠️  %s\n", strings.TrimSpace(stuckDetails))
+		}
+
+		t.Fatalf("Failed to delete namespace '%s': %v\nOutput: %s\n%s\n"+
 			"Troubleshooting:\n"+
 			"  1. Check for stuck finalizers: kubectl --context %s get namespace %s -o yaml\n"+
 			"  2. Manual cleanup: kubectl --context %s delete namespace %s",
-			config.WorkloadClusterNamespace, err, output,
+			config.WorkloadClusterNamespace, err, output, stuckDetails,
 			context, config.WorkloadClusterNamespace,
 			context, config.WorkloadClusterNamespace)
 	}
@@ -533,3 +678,22 @@ func TestDeletion_Summary(t *testing.T) {
 	PrintToTTY("\n=== Deletion Test Complete ===\n\n")
 	t.Log("Deletion test phase completed")
 }
+
+// deletionPhaseSteps returns this file's tests in the order they must run.
+func deletionPhaseSteps() []e2eStep {
+	return []e2eStep{
+		{name: "DeleteCluster", run: TestDeletion_DeleteCluster},
+		{name: "WaitForClusterDeletion", run: TestDeletion_WaitForClusterDeletion},
+		{name: "VerifyControlPlaneDeletion", run: TestDeletion_VerifyControlPlaneDeletion},
+		{name: "VerifyMachinePoolDeletion", run: TestDeletion_VerifyMachinePoolDeletion},
+		{name: "VerifyAzureResourcesDeletion", run: TestDeletion_VerifyAzureResourcesDeletion},
+		{name: "CollectEvents", run: TestDeletion_CollectEvents},
+		{name: "DeleteManagementClusterK8sTestNamespace", run: TestDeletion_DeleteManagementClusterK8sTestNamespace},
+		{name: "Summary", run: TestDeletion_Summary},
+	}
+}
+
+// TestDeletion_ZZZ_RunPhase runs deletionPhaseSteps in order via runOrderedPhase.
+func TestDeletion_ZZZ_RunPhase(t *testing.T) {
+	runOrderedPhase(t, deletionPhaseSteps())
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 // ============================================================================
@@ -33,6 +34,8 @@ func TestCleanup_VerifyKindClusterDeletion(t *testing.T) {
 		t.Fatalf("Configuration initialization failed: %s", *configError)
 	}
 
+	CheckToolsForPhase(t, "cleanup")
+
 	config := NewTestConfig()
 
 	PrintTestHeader(t, "TestCleanup_VerifyKindClusterDeletion",
@@ -113,6 +116,37 @@ func TestCleanup_VerifyKubeconfigRemoval(t *testing.T) {
 	t.Logf("Found %d kubeconfig files that would be cleaned up", len(matches))
 }
 
+// TestCleanup_RemoveKubeconfigs deletes the workload kubeconfig files written by the suite.
+// Each file is confirmed interactively unless FORCE=1; DRY_RUN=1 only lists them, since
+// the files are needed to reach the workload cluster while it still exists.
+func TestCleanup_RemoveKubeconfigs(t *testing.T) {
+	PrintTestHeader(t, "TestCleanup_RemoveKubeconfigs",
+		"Remove workload kubeconfig files created by the test suite")
+
+	config := NewTestConfig()
+	tempDir := config.SharedTempDir()
+
+	PrintToTTY("Cleanup mode: %s\n", config.CleanupMode)
+	removed, err := RemoveKubeconfigFiles(t, config.CleanupMode)
+	if err != nil {
+		PrintToTTY("❌ %v\n\n", err)
+		t.Errorf("Failed to remove kubeconfig files: %v", err)
+	}
+
+	if len(removed) == 0 {
+		PrintToTTY("No suite kubeconfig files removed from %s\n\n", tempDir)
+		t.Log("No suite kubeconfig files removed")
+		return
+	}
+
+	PrintToTTY("✅ Removed %d kubeconfig file(s):\n", len(removed))
+	for _, path := range removed {
+		PrintToTTY("  - %s\n", path)
+	}
+	PrintToTTY("\n")
+	t.Logf("Removed %d kubeconfig file(s): %s", len(removed), strings.Join(removed, ", "))
+}
+
 // TestCleanup_VerifyClonedRepositoryRemoval verifies cloned repositories can be identified.
 func TestCleanup_VerifyClonedRepositoryRemoval(t *testing.T) {
 	config := NewTestConfig()
@@ -379,6 +413,13 @@ func TestCleanup_VerifyResourceGroupStatus(t *testing.T) {
 		PrintToTTY("\nResources in group:\n%s\n", resources)
 	}
 
+	if config.UseExistingRG {
+		PrintToTTY("\nResource group is pre-existing (USE_EXISTING_RG) and will not be deleted\n")
+		PrintToTTY("Remove cluster resources with: ./scripts/cleanup-azure-resources.sh --prefix %s\n\n", config.ClusterNamePrefix)
+		t.Logf("Resource group '%s' is pre-existing, skipping group deletion", resourceGroup)
+		return
+	}
+
 	PrintToTTY("\nUse 'make clean-azure' to delete this resource group\n\n")
 	t.Logf("Resource group '%s' exists and contains resources", resourceGroup)
 }
@@ -402,6 +443,24 @@ func TestCleanup_VerifyOrphanedResources(t *testing.T) {
 		t.Skip("Not logged in to Azure CLI")
 	}
 
+	// Resource groups of this run: matched by run ID tag when tagged, by name prefix otherwise
+	groups, tagged, err := DiscoverOrphanedResourceGroups(t, ExecAzureClient{}, config.ClusterNamePrefix, config.ResourceTags[RunIDTagKey])
+	if err != nil {
+		PrintToTTY("Failed to discover resource groups for this run: %v\n\n", err)
+		t.Logf("Resource group discovery failed: %v", err)
+	} else {
+		matchedBy := fmt.Sprintf("name prefix '%s'", config.ClusterNamePrefix)
+		if tagged {
+			matchedBy = fmt.Sprintf("tag %s=%s", RunIDTagKey, config.ResourceTags[RunIDTagKey])
+		}
+		PrintToTTY("Resource groups for this run (%s): %d\n", matchedBy, len(groups))
+		for _, g := range groups {
+			PrintToTTY("  - %s\n", g.Name)
+		}
+		PrintToTTY("\n")
+		t.Logf("Found %d resource group(s) for this run by %s", len(groups), matchedBy)
+	}
+
 	// Check for resource-graph extension
 	_, err = RunCommandQuiet(t, "az", "extension", "show", "--name", "resource-graph")
 	if err != nil {
@@ -603,6 +662,18 @@ func TestCleanup_DryRunMode(t *testing.T) {
 	}
 
 	prefix := config.CAPIUser
+	plan, err := CleanupPlan(t, ExecAzureClient{}, prefix, config.OrphanMinAge)
+	if err != nil {
+		PrintToTTY("❌ Refusing to build a deletion plan: %v\n\n", err)
+		t.Fatalf("Deletion plan refused: %v", err)
+	}
+	PrintToTTY("Deletion plan for prefix '%s' (%d item(s) older than %v or of unknown age):\n", prefix, len(plan.Items), config.OrphanMinAge)
+	for _, line := range plan.FormatLines() {
+		PrintToTTY("  %s\n", line)
+	}
+	PrintToTTY("\n")
+	t.Logf("Deletion plan for prefix '%s':\n%s", prefix, strings.Join(plan.FormatLines(), "\n"))
+
 	PrintToTTY("Running cleanup script in dry-run mode...\n")
 	PrintToTTY("Prefix: %s\n\n", prefix)
 
@@ -627,11 +698,174 @@ func TestCleanup_DryRunMode(t *testing.T) {
 	}
 }
 
+// TestCleanup_DryRunListsExpectedResources checks that the cleanup script's dry-run output
+// mentions every orphaned resource the Go discovery helpers find for the same prefix.
+// A mismatch means the script's discovery logic has drifted from the Go side.
+func TestCleanup_DryRunListsExpectedResources(t *testing.T) {
+	config := NewTestConfig()
+
+	PrintTestHeader(t, "TestCleanup_DryRunListsExpectedResources",
+		"Verify cleanup script dry-run lists all resources found by Go discovery")
+
+	scriptPath := "../scripts/cleanup-azure-resources.sh"
+	if !FileExists(scriptPath) {
+		t.Skip("Cleanup script not found")
+	}
+
+	if !CommandExists("az") {
+		PrintToTTY("Azure CLI not available - skipping\n\n")
+		t.Skip("Azure CLI not available")
+	}
+
+	_, err := RunCommandQuiet(t, "az", "account", "show")
+	if err != nil {
+		PrintToTTY("Not logged in to Azure - skipping\n\n")
+		t.Skip("Not logged in to Azure CLI")
+	}
+
+	_, err = RunCommandQuiet(t, "az", "extension", "show", "--name", "resource-graph")
+	if err != nil {
+		PrintToTTY("Azure Resource Graph extension not installed - skipping\n\n")
+		t.Skip("Azure Resource Graph extension not installed")
+	}
+
+	prefix := config.CAPIUser
+	PrintToTTY("Discovering orphaned resources with prefix '%s'...\n", prefix)
+
+	var expected []OrphanedResource
+	discoverers := []struct {
+		name     string
+		discover func(*testing.T, AzureClient, string) ([]OrphanedResource, error)
+	}{
+		{"ARM resources", DiscoverOrphanedResources},
+		{"AD applications", DiscoverOrphanedADApplications},
+		{"service principals", DiscoverOrphanedServicePrincipals},
+	}
+	for _, d := range discoverers {
+		found, err := d.discover(t, ExecAzureClient{}, prefix)
+		if err != nil {
+			PrintToTTY("❌ Failed to discover %s: %v\n\n", d.name, err)
+			t.Fatalf("Failed to discover %s: %v", d.name, err)
+		}
+		PrintToTTY("  %-20s %d\n", d.name+":", len(found))
+		expected = append(expected, found...)
+	}
+	PrintToTTY("\n")
+
+	PrintToTTY("Running cleanup script in dry-run mode...\n")
+	output, err := RunCommand(t, "bash", scriptPath, "--prefix", prefix, "--dry-run")
+	if err != nil {
+		t.Logf("Cleanup script exited with error: %v", err)
+	}
+	t.Logf("Script output:\n%s", output)
+
+	if len(expected) > 0 && !strings.Contains(output, "DRY-RUN") {
+		t.Errorf("Cleanup script output does not indicate dry-run mode")
+	}
+
+	// Resources can be created or deleted between the two queries, so a missing entry
+	// is worth re-running before treating it as drift.
+	missing := FindResourcesMissingFromOutput(output, expected)
+	if len(missing) > 0 {
+		PrintToTTY("❌ %d resource(s) found by Go discovery are missing from the dry-run output:\n", len(missing))
+		var lines []string
+		for _, r := range missing {
+			PrintToTTY("  - [%s] %s\n", r.Kind, r.Name)
+			lines = append(lines, fmt.Sprintf("  - [%s] %s (%s)", r.Kind, r.Name, r.ID))
+		}
+		PrintToTTY("\n")
+		t.Errorf("Cleanup script dry-run did not list %d resource(s) found by Go discovery:\n%s\n\n"+
+			"The discovery logic in %s has likely drifted from the DiscoverOrphaned* helpers.",
+			len(missing), strings.Join(lines, "\n"), scriptPath)
+		return
+	}
+
+	PrintToTTY("✅ Dry-run output lists all %d resource(s) found by Go discovery\n\n", len(expected))
+	t.Logf("Dry-run output lists all %d discovered resource(s)", len(expected))
+}
+
+// TestCleanup_DeleteOrphanedResources deletes the CAPI_USER-prefixed Azure resources in the
+// deletion plan with ExecuteCleanupPlan. It only runs with DELETE_ORPHANS=true; each
+// deletion is then confirmed interactively unless FORCE=1, and DRY_RUN=1 only logs it.
+// Items of unknown age need ORPHAN_INCLUDE_UNKNOWN_AGE=true outside interactive mode.
+func TestCleanup_DeleteOrphanedResources(t *testing.T) {
+	config := NewTestConfig()
+	if !config.DeleteOrphans {
+		t.Skip("DELETE_ORPHANS is not set to true, skipping orphaned resource deletion")
+	}
+
+	PrintTestHeader(t, "TestCleanup_DeleteOrphanedResources",
+		"Delete orphaned Azure resources in the deletion plan")
+
+	if !CommandExists("az") {
+		PrintToTTY("Azure CLI not available - skipping\n\n")
+		t.Skip("Azure CLI not available")
+	}
+	if _, err := RunCommandQuiet(t, "az", "account", "show"); err != nil {
+		PrintToTTY("Not logged in to Azure - skipping\n\n")
+		t.Skip("Not logged in to Azure CLI")
+	}
+
+	prefix := config.CAPIUser
+	plan, err := CleanupPlan(t, ExecAzureClient{}, prefix, config.OrphanMinAge)
+	if err != nil {
+		PrintToTTY("❌ Refusing to build a deletion plan: %v\n\n", err)
+		t.Fatalf("Deletion plan refused: %v", err)
+	}
+	PrintToTTY("Cleanup mode: %s\n", config.CleanupMode)
+	PrintToTTY("Deletion plan for prefix '%s' (%d item(s)):\n", prefix, len(plan.Items))
+	for _, line := range plan.FormatLines() {
+		PrintToTTY("  %s\n", line)
+	}
+	PrintToTTY("\n")
+
+	results, err := ExecuteCleanupPlan(t, ExecAzureClient{}, plan, config.CleanupMode, config.OrphanMinAge, config.OrphanIncludeUnknownAge)
+	deleted := 0
+	for _, r := range results {
+		if r.Deleted {
+			deleted++
+		}
+	}
+	if err != nil {
+		PrintToTTY("❌ %v\n\n", err)
+		t.Errorf("Orphaned resource cleanup failed: %v", err)
+	}
+	PrintToTTY("Deleted %d of %d item(s)\n\n", deleted, len(plan.Items))
+	t.Logf("Deleted %d of %d orphaned resource(s) with prefix '%s'", deleted, len(plan.Items), prefix)
+}
+
 // TestCleanup_PrefixValidation verifies the cleanup script validates prefixes correctly.
 func TestCleanup_PrefixValidation(t *testing.T) {
 	PrintTestHeader(t, "TestCleanup_PrefixValidation",
 		"Verify cleanup script validates prefixes correctly")
 
+	// The Go-side validator must reject everything the script rejects, plus prefixes
+	// that are well-formed but too broad to clean safely.
+	PrintToTTY("Testing Go-side prefix validation...\n\n")
+	goRejected := []struct {
+		prefix string
+		desc   string
+	}{
+		{"UPPER", "uppercase letters"},
+		{"-start-hyphen", "starting with hyphen"},
+		{"with spaces", "containing spaces"},
+		{"special!chars", "containing special characters"},
+		{"", "empty"},
+		{"a", "too short"},
+		{"prod", "denylisted"},
+	}
+	for _, tc := range goRejected {
+		if err := ValidateCleanupPrefix(tc.prefix); err != nil {
+			PrintToTTY("Correctly rejected '%s' (%s)\n", tc.prefix, tc.desc)
+		} else {
+			t.Errorf("ValidateCleanupPrefix(%q) accepted a prefix %s", tc.prefix, tc.desc)
+		}
+	}
+	if err := ValidateCleanupPrefix("validprefix123"); err != nil {
+		t.Errorf("ValidateCleanupPrefix rejected valid prefix: %v", err)
+	}
+	PrintToTTY("\n")
+
 	scriptPath := "../scripts/cleanup-azure-resources.sh"
 	if !FileExists(scriptPath) {
 		t.Skip("Cleanup script not found")
@@ -707,6 +941,9 @@ func TestCleanup_NonExistentResourcesNoError(t *testing.T) {
 }
 
 // TestCleanup_ResourceDiscoveryPrefixMatching verifies prefix matching is accurate.
+// Discovery uses the configured match mode (ORPHAN_MATCH_MODE, default prefix) for every
+// resource type; this test also runs a contains-mode query and lists the extra names it
+// would pick up, i.e. what a broader mode would over-match.
 func TestCleanup_ResourceDiscoveryPrefixMatching(t *testing.T) {
 	config := NewTestConfig()
 
@@ -725,33 +962,49 @@ func TestCleanup_ResourceDiscoveryPrefixMatching(t *testing.T) {
 	}
 
 	prefix := config.CAPIUser
+	mode := config.OrphanMatchMode
+	if err := ValidateCleanupPrefix(prefix); err != nil {
+		t.Fatalf("Refusing to run discovery: %v", err)
+	}
 
-	PrintToTTY("Testing prefix matching accuracy for '%s'...\n\n", prefix)
-
-	// The script uses 'contains' not 'startswith' for Resource Graph
-	// Let's verify what the script would match
-
-	// Check AD apps with startswith (should be accurate)
-	filter := fmt.Sprintf("startswith(displayName, '%s')", prefix)
-	adApps, _ := RunCommandQuiet(t, "az", "ad", "app", "list", "--filter", filter, "--query", "[].displayName", "-o", "json")
+	PrintToTTY("Testing prefix matching accuracy for '%s' (match mode: %s)...\n\n", prefix, mode)
 
-	PrintToTTY("AD Apps with startswith filter:\n")
-	if adApps == "" || adApps == "[]" {
-		PrintToTTY("  (none found)\n")
-	} else {
-		PrintToTTY("  %s\n", adApps)
+	kinds := []string{OrphanKindADApplication, OrphanKindManagedIdentity}
+	if _, err := RunCommandQuiet(t, "az", "extension", "show", "--name", "resource-graph"); err == nil {
+		kinds = append(kinds, OrphanKindResource)
 	}
 
-	// For Resource Graph, 'contains' is used which is more permissive
-	_, err = RunCommandQuiet(t, "az", "extension", "show", "--name", "resource-graph")
-	if err == nil {
-		query := fmt.Sprintf("Resources | where name contains '%s' | project name | limit 5", prefix)
-		resources, _ := RunCommandQuiet(t, "az", "graph", "query", "-q", query, "-o", "json")
-		PrintToTTY("\nResources with 'contains' filter:\n")
-		if resources == "" || strings.Contains(resources, "\"data\": []") {
+	configured := orphanQueries(prefix, mode)
+	broad := orphanQueries(prefix, MatchModeContains)
+	for _, kind := range kinds {
+		matched, err := runOrphanQuery(t, ExecAzureClient{}, configured[kind], config.OrphanQueryTimeout)
+		if err != nil {
+			PrintToTTY("%s: could not check (%v)\n", kind, err)
+			continue
+		}
+
+		PrintToTTY("%s matched in %s mode:\n", kind, mode)
+		if len(matched) == 0 {
 			PrintToTTY("  (none found)\n")
-		} else {
-			PrintToTTY("  %s\n", resources)
+		}
+		for _, r := range matched {
+			PrintToTTY("  %s\n", r.Name)
+			if !mode.Matches(r.Name, prefix) {
+				t.Errorf("%s '%s' was returned by %s-mode discovery but does not match prefix '%s'", kind, r.Name, mode, prefix)
+			}
+		}
+
+		if mode == MatchModeContains {
+			continue
+		}
+		contained, err := runOrphanQuery(t, ExecAzureClient{}, broad[kind], config.OrphanQueryTimeout)
+		if err != nil {
+			continue
+		}
+		for _, r := range contained {
+			if !mode.Matches(r.Name, prefix) {
+				PrintToTTY("  (excluded, would match in contains mode: %s)\n", r.Name)
+			}
 		}
 	}
 
@@ -854,22 +1107,51 @@ func TestCleanup_Summary(t *testing.T) {
 		if err != nil {
 			PrintToTTY("  (Not logged in - cannot check)\n")
 		} else {
-			resourceGroup := config.ResourceGroupName
-			_, err := RunCommandQuiet(t, "az", "group", "show", "--name", resourceGroup)
-			if err == nil {
-				PrintToTTY("  Resource Group:   EXISTS (%s)\n", resourceGroup)
-			} else {
+			// The run's own resource groups are looked up separately: their default
+			// <workload>-<runID>-resgroup names do not start with CAPI_USER, so
+			// prefix discovery below would not report them.
+			groups, _, err := DiscoverOrphanedResourceGroups(t, ExecAzureClient{}, config.ClusterNamePrefix, config.ResourceTags[RunIDTagKey])
+			switch {
+			case err != nil:
+				PrintToTTY("  Resource Group:   (could not check: %v)\n", err)
+			case len(groups) == 0:
 				PrintToTTY("  Resource Group:   CLEAN\n")
+			default:
+				for _, g := range groups {
+					PrintToTTY("  Resource Group:   EXISTS (%s)\n", g.Name)
+				}
 			}
 
-			// Check AD apps
-			filter := fmt.Sprintf("startswith(displayName, '%s')", config.CAPIUser)
-			output, _ := RunCommandQuiet(t, "az", "ad", "app", "list", "--filter", filter, "-o", "json")
-			if output != "" && output != "[]" {
-				PrintToTTY("  AD Apps:          Some exist with prefix '%s'\n", config.CAPIUser)
-			} else {
-				PrintToTTY("  AD Apps:          CLEAN\n")
+			report, err := DiscoverAllOrphans(t, ExecAzureClient{}, config.CAPIUser, config.OrphanMatchMode, config.OrphanQueryTimeout)
+			if err != nil {
+				PrintToTTY("  (Refusing to check: %v)\n", err)
+				t.Errorf("Orphaned resource discovery refused: %v", err)
+				return
+			}
+			orphans := report.FilterOlderThan(config.OrphanMinAge)
+			PrintToTTY("  Prefix:           %s (match mode: %s, checked in %v)\n",
+				report.Prefix, config.OrphanMatchMode, report.Duration.Round(time.Second))
+			for _, line := range orphans.FormatLines() {
+				PrintToTTY("  %s\n", line)
+			}
+			// With a zero min age nothing is filtered, so unknown-age items are already counted
+			unknownAge := &OrphanReport{}
+			if config.OrphanMinAge > 0 {
+				unknownAge = report.UnknownAge()
 			}
+			if newer := report.Total() - orphans.Total() - unknownAge.Total(); newer > 0 {
+				PrintToTTY("  (%d more newer than %v - not counted as orphans)\n", newer, config.OrphanMinAge)
+			}
+			if unknownAge.Total() > 0 {
+				PrintToTTY("  Age unknown (check manually):\n")
+				for _, kind := range OrphanReportKinds {
+					for _, res := range unknownAge.Resources[kind] {
+						PrintToTTY("    - [%s] %s\n", kind, res.Name)
+					}
+				}
+			}
+			t.Logf("Found %d orphaned Azure resource(s) with prefix '%s' older than %v and %d of unknown age (%d total)",
+				orphans.Total(), report.Prefix, config.OrphanMinAge, unknownAge.Total(), report.Total())
 		}
 	}
 
@@ -881,3 +1163,37 @@ func TestCleanup_Summary(t *testing.T) {
 
 	t.Log("Cleanup summary complete")
 }
+
+// cleanupPhaseSteps returns this file's tests in the order they must run.
+func cleanupPhaseSteps() []e2eStep {
+	return []e2eStep{
+		{name: "VerifyKindClusterDeletion", run: TestCleanup_VerifyKindClusterDeletion},
+		{name: "VerifyKubeconfigRemoval", run: TestCleanup_VerifyKubeconfigRemoval},
+		{name: "RemoveKubeconfigs", run: TestCleanup_RemoveKubeconfigs},
+		{name: "VerifyClonedRepositoryRemoval", run: TestCleanup_VerifyClonedRepositoryRemoval},
+		{name: "VerifyResultsDirectoryRemoval", run: TestCleanup_VerifyResultsDirectoryRemoval},
+		{name: "VerifyDeploymentStateFile", run: TestCleanup_VerifyDeploymentStateFile},
+		{name: "VerifyManagementClusterK8sTestNamespaceRemoval", run: TestCleanup_VerifyManagementClusterK8sTestNamespaceRemoval},
+		{name: "VerifyOrphanedManagementClusterK8sTestNamespaces", run: TestCleanup_VerifyOrphanedManagementClusterK8sTestNamespaces},
+		{name: "AzureCLIAvailability", run: TestCleanup_AzureCLIAvailability},
+		{name: "AzureAuthentication", run: TestCleanup_AzureAuthentication},
+		{name: "VerifyResourceGroupStatus", run: TestCleanup_VerifyResourceGroupStatus},
+		{name: "VerifyOrphanedResources", run: TestCleanup_VerifyOrphanedResources},
+		{name: "VerifyADApplications", run: TestCleanup_VerifyADApplications},
+		{name: "VerifyServicePrincipals", run: TestCleanup_VerifyServicePrincipals},
+		{name: "ScriptExists", run: TestCleanup_ScriptExists},
+		{name: "ScriptHelpWorks", run: TestCleanup_ScriptHelpWorks},
+		{name: "DryRunMode", run: TestCleanup_DryRunMode},
+		{name: "DryRunListsExpectedResources", run: TestCleanup_DryRunListsExpectedResources},
+		{name: "DeleteOrphanedResources", run: TestCleanup_DeleteOrphanedResources},
+		{name: "PrefixValidation", run: TestCleanup_PrefixValidation},
+		{name: "NonExistentResourcesNoError", run: TestCleanup_NonExistentResourcesNoError},
+		{name: "ResourceDiscoveryPrefixMatching", run: TestCleanup_ResourceDiscoveryPrefixMatching},
+		{name: "Summary", run: TestCleanup_Summary},
+	}
+}
+
+// TestCleanup_ZZZ_RunPhase runs cleanupPhaseSteps in order via runOrderedPhase.
+func TestCleanup_ZZZ_RunPhase(t *testing.T) {
+	runOrderedPhase(t, cleanupPhaseSteps())
+}
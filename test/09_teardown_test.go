@@ -15,6 +15,8 @@ func TestTeardown_RevertMCEComponents(t *testing.T) {
 		t.Fatalf("Configuration initialization failed: %s", *configError)
 	}
 
+	CheckToolsForPhase(t, "teardown")
+
 	config := NewTestConfig()
 
 	if !config.IsExternalCluster() {
@@ -33,3 +35,15 @@ func TestTeardown_RevertMCEComponents(t *testing.T) {
 
 	RestoreMCEOriginalStates(t, context)
 }
+
+// teardownPhaseSteps returns this file's tests in the order they must run.
+func teardownPhaseSteps() []e2eStep {
+	return []e2eStep{
+		{name: "RevertMCEComponents", run: TestTeardown_RevertMCEComponents},
+	}
+}
+
+// TestTeardown_ZZZ_RunPhase runs teardownPhaseSteps in order via runOrderedPhase.
+func TestTeardown_ZZZ_RunPhase(t *testing.T) {
+	runOrderedPhase(t, teardownPhaseSteps())
+}
@@ -3,6 +3,7 @@ package test
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os/exec"
 	"strings"
 	"testing"
@@ -130,7 +131,8 @@ func checkManagementClusterHealth(t *testing.T, kubeContext string) error {
 	t.Helper()
 
 	// #nosec G204 -- kubeContext is validated upstream as RFC 1123 compliant
-	cmd := exec.Command("kubectl", "--context", kubeContext, "get", "ns", "--request-timeout=5s")
+	cmd := exec.CommandContext(RunContext(), "kubectl", "--context", kubeContext, "get", "ns", "--request-timeout=5s")
+	applyCommandEnv(cmd)
 	output, err := cmd.CombinedOutput()
 	if err == nil {
 		return nil
@@ -194,7 +196,8 @@ func MonitorCluster(t *testing.T, kubeContext, namespace, clusterName string) (*
 	// Run the monitoring script with --context parameter
 	// #nosec G204 -- scriptPath is hardcoded, and kubeContext/namespace/clusterName are validated
 	// as RFC 1123 compliant (alphanumeric + hyphens only), making shell injection impossible
-	cmd := exec.Command("bash", scriptPath, "--context", kubeContext, namespace, clusterName)
+	cmd := exec.CommandContext(RunContext(), "bash", scriptPath, "--context", kubeContext, namespace, clusterName)
+	applyCommandEnv(cmd)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return nil, fmt.Errorf("failed to run monitor script: %w\nOutput: %s", err, string(output))
@@ -209,6 +212,33 @@ func MonitorCluster(t *testing.T, kubeContext, namespace, clusterName string) (*
 	return &data, nil
 }
 
+// Condition returns the condition of the given type, or nil if the cluster does not report it.
+func (s *ClusterStatus) Condition(conditionType string) *K8sCondition {
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == conditionType {
+			return &s.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// FailingConditions returns "Type: message" for each condition whose status is False,
+// in the order the cluster reports them.
+func (s *ClusterStatus) FailingConditions() []string {
+	var failing []string
+	for _, c := range s.Conditions {
+		if c.Status != "False" {
+			continue
+		}
+		detail := c.Message
+		if detail == "" {
+			detail = c.Reason
+		}
+		failing = append(failing, fmt.Sprintf("%s: %s", c.Type, detail))
+	}
+	return failing
+}
+
 // GetProviderType detects the provider type based on infrastructure and control plane kinds.
 // Returns "aro", "rosa", or "unknown"
 func (d *ClusterMonitorData) GetProviderType() string {
@@ -475,7 +505,8 @@ func MonitorClusterUntilDeleted(t *testing.T, kubeContext, namespace, clusterNam
 		PrintToTTY("[%d] Checking deletion status...\n", iteration)
 		t.Logf("[%d] Checking if cluster is deleted (elapsed: %v)...", iteration, elapsed.Round(time.Second))
 
-		_, err := MonitorCluster(t, kubeContext, namespace, clusterName)
+		status := "phase=unknown"
+		data, err := MonitorCluster(t, kubeContext, namespace, clusterName)
 		if err != nil {
 			// Check if this is "not found" (deletion complete) vs. a real error
 			errMsg := err.Error()
@@ -495,14 +526,107 @@ func MonitorClusterUntilDeleted(t *testing.T, kubeContext, namespace, clusterNam
 			}
 		} else {
 			consecutiveFailures = 0
+			status = "phase=" + data.Cluster.Phase
+			if data.ControlPlane.State != nil && *data.ControlPlane.State != "" {
+				status += ", cp-state=" + *data.ControlPlane.State
+			}
 		}
 
 		// Cluster still exists
 		PrintToTTY("[%d] ⏳ Cluster still exists, waiting for deletion...\n", iteration)
 		t.Logf("[%d] Cluster still exists, waiting for deletion...", iteration)
 
-		// Report progress
-		ReportProgress(t, iteration, elapsed, remaining, timeout)
+		// Report progress with the status just observed
+		ReportProgressWithStatus(t, iteration, elapsed, remaining, timeout, status)
+
+		time.Sleep(pollInterval)
+	}
+}
+
+// ClusterStatusEvent is a single machine-readable status line emitted by MonitorClusterJSON.
+// Field names follow the camelCase convention of monitor-cluster-json.sh output.
+type ClusterStatusEvent struct {
+	Timestamp              string         `json:"timestamp"`
+	Iteration              int            `json:"iteration"`
+	ElapsedSeconds         int64          `json:"elapsedSeconds"`
+	Namespace              string         `json:"namespace"`
+	ClusterName            string         `json:"clusterName"`
+	Provider               string         `json:"provider,omitempty"`
+	Phase                  string         `json:"phase,omitempty"`
+	InfrastructureReady    bool           `json:"infrastructureReady"`
+	ControlPlaneReady      bool           `json:"controlPlaneReady"`
+	ControlPlaneState      *string        `json:"controlPlaneState,omitempty"`
+	NodeCount              int            `json:"nodeCount"`
+	ReadyNodeCount         int            `json:"readyNodeCount"`
+	Conditions             []K8sCondition `json:"conditions,omitempty"`
+	ControlPlaneConditions []K8sCondition `json:"controlPlaneConditions,omitempty"`
+	Error                  string         `json:"error,omitempty"`
+}
+
+// NewClusterStatusEvent builds a status event from monitor data. If data is nil,
+// monitorErr is recorded in the Error field instead.
+func NewClusterStatusEvent(namespace, clusterName string, iteration int, elapsed time.Duration, now time.Time, data *ClusterMonitorData, monitorErr error) ClusterStatusEvent {
+	event := ClusterStatusEvent{
+		Timestamp:      now.UTC().Format(time.RFC3339),
+		Iteration:      iteration,
+		ElapsedSeconds: int64(elapsed / time.Second),
+		Namespace:      namespace,
+		ClusterName:    clusterName,
+	}
+
+	if data == nil {
+		if monitorErr != nil {
+			event.Error = monitorErr.Error()
+		}
+		return event
+	}
+
+	event.Provider = data.GetProviderType()
+	event.Phase = data.Summary.Phase
+	event.InfrastructureReady = data.Summary.InfrastructureReady
+	event.ControlPlaneReady = data.Summary.ControlPlaneReady
+	event.ControlPlaneState = data.ControlPlane.State
+	event.NodeCount = data.Summary.NodeCount
+	event.ReadyNodeCount = data.GetReadyNodeCount()
+	event.Conditions = data.Cluster.Conditions
+	event.ControlPlaneConditions = data.ControlPlane.Conditions
+	return event
+}
+
+// MonitorClusterJSON polls the cluster status and writes one JSON object per poll to out
+// (newline-delimited JSON), suitable for piping into dashboards or other tooling.
+// Polling stops when the cluster is ready, its phase is Failed, or DefaultClusterReadyTimeout
+// is reached. Monitoring failures are emitted as events with the error field set.
+func MonitorClusterJSON(t *testing.T, kubeContext, namespace, clusterName string, out io.Writer) error {
+	t.Helper()
+
+	pollInterval := DefaultClusterReadyPollInterval
+	timeout := DefaultClusterReadyTimeout
+	startTime := time.Now()
+	encoder := json.NewEncoder(out)
+	iteration := 0
+
+	for {
+		elapsed := time.Since(startTime)
+		if elapsed > timeout {
+			return fmt.Errorf("timeout waiting for cluster to be ready after %v", elapsed.Round(time.Second))
+		}
+
+		iteration++
+		data, err := MonitorCluster(t, kubeContext, namespace, clusterName)
+		event := NewClusterStatusEvent(namespace, clusterName, iteration, elapsed, time.Now(), data, err)
+		if encErr := encoder.Encode(event); encErr != nil {
+			return fmt.Errorf("failed to write status event: %w", encErr)
+		}
+
+		if data != nil {
+			if data.Cluster.Phase == ClusterPhaseFailed {
+				return fmt.Errorf("cluster phase is 'Failed' — deployment cannot recover")
+			}
+			if data.IsReady() {
+				return nil
+			}
+		}
 
 		time.Sleep(pollInterval)
 	}
@@ -1,8 +1,12 @@
 package test
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
+	"time"
 )
 
 // TestMonitorCluster demonstrates how to use the generic cluster monitoring.
@@ -80,3 +84,63 @@ func TestMonitorCluster(t *testing.T) {
 		}
 	})
 }
+
+func TestNewClusterStatusEvent(t *testing.T) {
+	now := time.Date(2026, 2, 2, 12, 0, 0, 0, time.UTC)
+	state := "installing"
+
+	t.Run("from monitor data", func(t *testing.T) {
+		data := &ClusterMonitorData{
+			Cluster:        ClusterStatus{Conditions: []K8sCondition{{Type: "Ready", Status: "False"}}},
+			ControlPlane:   ControlPlaneStatus{Kind: "AROControlPlane", State: &state},
+			Infrastructure: InfrastructureStatus{Kind: "AROCluster"},
+			Nodes:          []NodeStatus{{Name: "n1", Ready: "True"}, {Name: "n2", Ready: "False"}},
+			Summary:        ClusterSummary{Phase: "Provisioning", InfrastructureReady: true, NodeCount: 2},
+		}
+
+		event := NewClusterStatusEvent("ns", "cluster", 3, 95*time.Second, now, data, nil)
+
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(event); err != nil {
+			t.Fatalf("failed to encode event: %v", err)
+		}
+		if strings.Count(buf.String(), "\n") != 1 {
+			t.Errorf("expected a single JSON line, got %q", buf.String())
+		}
+
+		var decoded map[string]interface{}
+		if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+			t.Fatalf("event is not valid JSON: %v", err)
+		}
+		expected := map[string]interface{}{
+			"timestamp":           "2026-02-02T12:00:00Z",
+			"iteration":           float64(3),
+			"elapsedSeconds":      float64(95),
+			"provider":            "aro",
+			"phase":               "Provisioning",
+			"infrastructureReady": true,
+			"controlPlaneReady":   false,
+			"controlPlaneState":   "installing",
+			"nodeCount":           float64(2),
+			"readyNodeCount":      float64(1),
+		}
+		for key, want := range expected {
+			if decoded[key] != want {
+				t.Errorf("%s = %v, want %v", key, decoded[key], want)
+			}
+		}
+		if _, ok := decoded["error"]; ok {
+			t.Error("error field should be omitted when monitoring succeeded")
+		}
+	})
+
+	t.Run("from monitor error", func(t *testing.T) {
+		event := NewClusterStatusEvent("ns", "cluster", 1, 0, now, nil, errors.New("script failed"))
+		if event.Error != "script failed" {
+			t.Errorf("Error = %q, want %q", event.Error, "script failed")
+		}
+		if event.Phase != "" {
+			t.Errorf("Phase = %q, want empty", event.Phase)
+		}
+	})
+}
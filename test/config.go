@@ -5,8 +5,15 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"math"
 	"os"
 	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -40,6 +47,17 @@ const (
 	// MCE components need time to deploy controllers, pull images, and initialize.
 	DefaultMCEEnablementTimeout = 15 * time.Minute
 
+	// DefaultE2ETimeout is the default overall deadline for the TestE2E_* orchestration tests.
+	// It covers YAML generation, CR application, control plane provisioning, and verification.
+	DefaultE2ETimeout = 90 * time.Minute
+
+	// DefaultOrphanQueryTimeout is the default timeout for each az query run by DiscoverAllOrphans.
+	DefaultOrphanQueryTimeout = 60 * time.Second
+
+	// DefaultOrphanMinAge is the default minimum age for a resource to be treated as orphaned.
+	// Younger resources may belong to another in-flight deployment sharing the prefix.
+	DefaultOrphanMinAge = 2 * time.Hour
+
 	// DefaultDeploymentStallTimeout is the default stall detection timeout for the infrastructure phase.
 	// After infrastructure resources are fully reconciled, the timeout doubles (2x) for the
 	// post-infrastructure phase where the hosted control plane provisioning is opaque.
@@ -51,6 +69,34 @@ const (
 	// The AROMachinePool creates nodes after the HcpOpenShiftCluster is up.
 	DefaultNodeReadyTimeout = 30 * time.Minute
 
+	// DeletionPollInitialInterval is the first poll interval while waiting for cluster deletion
+	// (Phase 07). It grows by DELETION_POLL_BACKOFF_FACTOR up to DELETION_POLL_MAX_INTERVAL.
+	DeletionPollInitialInterval = 15 * time.Second
+
+	// DefaultDeletionPollMaxInterval is the default cap on the deletion poll interval.
+	DefaultDeletionPollMaxInterval = 2 * time.Minute
+
+	// DefaultDeletionPollBackoffFactor is the default growth of the deletion poll interval
+	// after each check (15s, 22s, 33s, 50s, 75s, 112s, then 2m).
+	DefaultDeletionPollBackoffFactor = 1.5
+
+	// DefaultMustGatherTimeout bounds `oc adm must-gather` when COLLECT_MUST_GATHER is set.
+	// A full gather of a small cluster usually takes 5-15 minutes.
+	DefaultMustGatherTimeout = 30 * time.Minute
+
+	// DefaultClusterctlMinVersion is the oldest clusterctl whose `describe cluster
+	// --show-conditions=all` output the monitor test is known to handle.
+	DefaultClusterctlMinVersion = "v1.9.0"
+
+	// DefaultMinFreeDiskSpace is the default minimum free space required on the container
+	// runtime data root and the temp directory. A kind node image plus the CAPI/CAPZ/ASO
+	// controller images need several GiB; 10 GiB leaves headroom for logs and etcd.
+	DefaultMinFreeDiskSpace uint64 = 10 << 30
+
+	// DefaultManifestSchemaLocation is the kubeconform schema location used for CRDs
+	// (CAPI, CAPZ, ASO, CAPA) in addition to the built-in Kubernetes schemas.
+	DefaultManifestSchemaLocation = "https://raw.githubusercontent.com/datreeio/CRDs-catalog/main/{{.Group}}/{{.ResourceKind}}_{{.ResourceAPIVersion}}.json"
+
 	// DefaultCAPIUser is the default user identifier for CAPI resources.
 	// Used in ClusterNamePrefix (for resource group naming) and User field.
 	// Extracted to a constant to ensure consistency across all usages.
@@ -88,6 +134,42 @@ const (
 	CAPIDeploymentChartName = "cluster-api"
 )
 
+// Environment variable keys for the settings that NewTestConfig reads and runGenerationScript
+// hands back to the generation script. Both sides reference these constants so one logical
+// setting cannot drift to a second spelling; TestEnvKeys_SingleSpelling enforces it.
+const (
+	EnvDeploymentEnv            = "DEPLOYMENT_ENV"
+	EnvCAPIUser                 = "CAPI_USER"
+	EnvOSUser                   = "USER"
+	EnvManagementClusterName    = "MANAGEMENT_CLUSTER_NAME"
+	EnvWorkloadClusterName      = "WORKLOAD_CLUSTER_NAME"
+	EnvWorkloadClusterNamespace = "WORKLOAD_CLUSTER_NAMESPACE"
+	EnvClusterNamePrefix        = "CS_CLUSTER_NAME"
+	EnvResourceGroupName        = "RESOURCEGROUPNAME"
+	EnvOCPVersion               = "OCP_VERSION"
+	EnvOCPVersionMP             = "OCP_VERSION_MP"
+	EnvMachineSKU               = "MACHINE_SKU"
+	EnvAzureSubscriptionName    = "AZURE_SUBSCRIPTION_NAME"
+	EnvAzureRegion              = "REGION"
+	EnvAWSRegion                = "AWS_REGION"
+
+	// EnvGenOpenShiftVersion is the ROSA gen.sh name for the cluster version; it is only
+	// ever set (from OCPVersion), never read.
+	EnvGenOpenShiftVersion = "OPENSHIFT_VERSION"
+	// EnvGenNamespace is the generation script's name for the workload cluster namespace;
+	// it is only ever set (from WorkloadClusterNamespace), never read.
+	EnvGenNamespace = "NAMESPACE"
+	// EnvGenKindClusterName is the deployment script's name for the management cluster; it is
+	// only ever set (from ManagementClusterName), never read.
+	EnvGenKindClusterName = "KIND_CLUSTER_NAME"
+)
+
+// CAPICoreCRDs are the CAPI core CRDs every provider's cluster YAML uses.
+var CAPICoreCRDs = []string{
+	"clusters.cluster.x-k8s.io",
+	"machinepools.cluster.x-k8s.io",
+}
+
 // ControllerDef describes a controller deployment to validate.
 type ControllerDef struct {
 	DisplayName    string        // human-readable name (e.g., "CAPZ", "ASO")
@@ -133,8 +215,16 @@ type InfraProvider struct {
 	RequiredScripts    []string             // repo-relative scripts this provider needs (validated in Phase 2)
 	YAMLGenCredentials []EnvVarRequirement  // credentials required for YAML generation (Phase 04)
 	ExpectedFiles      []string             // YAML files expected to be generated by gen.sh script
+	ApplyOrder         ApplyOrder           // files each ExpectedFiles entry must be applied after
+	RequiredCRDs       []string             // CRDs the generated files use, established before applying
 }
 
+// ApplyOrder maps a generated file to the files that must be applied before it, e.g. the
+// cluster YAML after the credentials it references. A cluster applied before its
+// credentials starts reconciling with an identity that does not exist yet and cannot
+// authenticate.
+type ApplyOrder map[string][]string
+
 // SensitiveKeyNames returns the names of all environment variables marked as
 // sensitive in this provider's YAMLGenCredentials, plus any redaction aliases.
 // Used by redactCommand to build the redaction pattern from config rather than
@@ -197,6 +287,16 @@ func NewAzureProvider(namespace string) InfraProvider {
 			{Name: "AZURE_CLIENT_SECRET", Desc: "Azure service principal client secret", Sensitive: true, RedactionAliases: []string{"clientSecret"}},
 		},
 		ExpectedFiles: []string{"credentials.yaml", "aro.yaml"},
+		ApplyOrder: ApplyOrder{
+			"aro.yaml": {"credentials.yaml"},
+		},
+		RequiredCRDs: []string{
+			"arocontrolplanes.controlplane.cluster.x-k8s.io",
+			"aroclusters.infrastructure.cluster.x-k8s.io",
+			"aromachinepools.infrastructure.cluster.x-k8s.io",
+			"azureclusteridentities.infrastructure.cluster.x-k8s.io",
+			"resourcegroups.resources.azure.com",
+		},
 	}
 }
 
@@ -244,6 +344,16 @@ func NewAWSProvider(namespace string) InfraProvider {
 			{Name: "OCM_CLIENT_SECRET", Desc: "OCM OAuth client secret", Sensitive: true, RedactionAliases: []string{"clientSecret"}},
 		},
 		ExpectedFiles: []string{"secrets.yaml", "is.yaml", "rosa.yaml"},
+		ApplyOrder: ApplyOrder{
+			"is.yaml":   {"secrets.yaml"},
+			"rosa.yaml": {"secrets.yaml", "is.yaml"},
+		},
+		RequiredCRDs: []string{
+			"rosacontrolplanes.controlplane.cluster.x-k8s.io",
+			"rosaclusters.infrastructure.cluster.x-k8s.io",
+			"rosamachinepools.infrastructure.cluster.x-k8s.io",
+			"awsclusterstaticidentities.infrastructure.cluster.x-k8s.io",
+		},
 	}
 }
 
@@ -260,6 +370,10 @@ var (
 	resourceGroupName     string
 	resourceGroupNameOnce sync.Once
 
+	// Deprecated cluster name accessors warn once each
+	kindClusterNameWarnOnce sync.Once
+	clusterNameWarnOnce     sync.Once
+
 	// cachedResourceTags holds tags loaded from the deployment state file on resume.
 	// nil means tags were not loaded from state (fresh run or explicit CS_CLUSTER_NAME),
 	// so fresh tags will be generated.
@@ -288,10 +402,10 @@ func getDefaultRepoDir() string {
 // falling back to the OS username ($USER) sanitized for RFC 1123 compliance,
 // then to DefaultCAPIUser as a last resort.
 func getCAPIUser() string {
-	if v := os.Getenv("CAPI_USER"); v != "" {
+	if v := os.Getenv(EnvCAPIUser); v != "" {
 		return v
 	}
-	if v := os.Getenv("USER"); v != "" {
+	if v := os.Getenv(EnvOSUser); v != "" {
 		if s := SanitizeToRFC1123(v); s != "" {
 			return s
 		}
@@ -317,7 +431,7 @@ func getCAPIUser() string {
 func getWorkloadClusterNamespace(defaultPrefix string) string {
 	workloadClusterNamespaceOnce.Do(func() {
 		// Check if a full namespace is explicitly provided (for resume scenarios)
-		if ns := os.Getenv("WORKLOAD_CLUSTER_NAMESPACE"); ns != "" {
+		if ns := os.Getenv(EnvWorkloadClusterNamespace); ns != "" {
 			workloadClusterNamespace = ns
 			return
 		}
@@ -362,7 +476,7 @@ func getWorkloadClusterNamespace(defaultPrefix string) string {
 func getClusterNamePrefix(capiUser string) string {
 	clusterNamePrefixOnce.Do(func() {
 		// Check if explicitly provided
-		if prefix := GetEnvOrDefault("CS_CLUSTER_NAME", ""); prefix != "" {
+		if prefix := GetEnvOrDefault(EnvClusterNamePrefix, ""); prefix != "" {
 			clusterNamePrefix = prefix
 			return
 		}
@@ -418,12 +532,17 @@ func getClusterNamePrefix(capiUser string) string {
 // to prevent parallel runs from interfering with each other's Azure resources.
 //
 // Resolution order:
-// 1. RESOURCEGROUPNAME env var (explicit override)
-// 2. Existing deployment state file in RepoDir (auto-resume from previous run)
-// 3. Generate unique name: ${workloadClusterName}-${runID}-resgroup
+// 1. EXISTING_RESOURCE_GROUP env var (pre-provisioned group, see parseUseExistingRG)
+// 2. RESOURCEGROUPNAME env var (explicit override)
+// 3. Existing deployment state file in RepoDir (auto-resume from previous run)
+// 4. Generate unique name: ${workloadClusterName}-${runID}-resgroup
 func getResourceGroupName(workloadClusterName, runID string) string {
 	resourceGroupNameOnce.Do(func() {
-		if rg := GetEnvOrDefault("RESOURCEGROUPNAME", ""); rg != "" {
+		if rg := os.Getenv("EXISTING_RESOURCE_GROUP"); rg != "" {
+			resourceGroupName = rg
+			return
+		}
+		if rg := GetEnvOrDefault(EnvResourceGroupName, ""); rg != "" {
 			resourceGroupName = rg
 			return
 		}
@@ -441,16 +560,21 @@ func getResourceGroupName(workloadClusterName, runID string) string {
 			}
 		}
 
-		if runID != "" {
-			resourceGroupName = fmt.Sprintf("%s-%s-resgroup", workloadClusterName, runID)
-		} else {
-			resourceGroupName = fmt.Sprintf("%s-resgroup", workloadClusterName)
-		}
+		resourceGroupName = defaultResourceGroupName(workloadClusterName, runID)
 	})
 
 	return resourceGroupName
 }
 
+// defaultResourceGroupName returns the generated resource group name for a workload cluster:
+// ${workloadClusterName}-${runID}-resgroup, or ${workloadClusterName}-resgroup without a run ID.
+func defaultResourceGroupName(workloadClusterName, runID string) string {
+	if runID != "" {
+		return fmt.Sprintf("%s-%s-resgroup", workloadClusterName, runID)
+	}
+	return fmt.Sprintf("%s-resgroup", workloadClusterName)
+}
+
 // generateRunID creates a random hex string of the specified length.
 // Uses crypto/rand for unpredictable values. Panics if crypto/rand fails,
 // as this indicates a serious system issue (e.g., /dev/urandom unavailable)
@@ -468,7 +592,9 @@ type TestConfig struct {
 	// Repository configuration
 	RepoURL    string
 	RepoBranch string
+	RepoCommit string // Optional commit SHA to pin the repository to (ARO_REPO_COMMIT)
 	RepoDir    string
+	CloneDepth int // Shallow clone depth passed to git --depth (CLONE_DEPTH); 0 means full clone
 
 	// Cluster configuration
 	ManagementClusterName    string
@@ -477,6 +603,7 @@ type TestConfig struct {
 	NamePrefix               string // NAME_PREFIX used for Azure resource naming (Key Vault, node pools); passed to YAML generation
 	OCPVersion               string
 	OCPVersionMP             string // Full x.y.z OpenShift version for MachinePool workers (from OCP_VERSION_MP env var)
+	MachineSKU               string // VM size for MachinePool workers (from MACHINE_SKU env var, empty = generator default)
 	Region                   string
 	AzureSubscriptionName    string // Azure subscription name (from AZURE_SUBSCRIPTION_NAME env var)
 	Environment              string
@@ -485,10 +612,17 @@ type TestConfig struct {
 	TestLabelPrefix          string            // Provider-specific label prefix for test namespaces (e.g., "capz-test" for ARO, "capa-test" for ROSA)
 	TestRunID                string            // Unique run identifier extracted from ClusterNamePrefix (the part after CAPI_USER-). Empty when prefix does not start with CAPI_USER-.
 	ResourceTags             map[string]string // Tags applied to all created cloud resources (Azure RGs, AWS stacks/VPCs) for ownership tracking and cleanup
-	ResourceGroupName        string            // Azure resource group name (env: RESOURCEGROUPNAME, default: ${WorkloadClusterName}-${runID}-resgroup)
+	ResourceGroupName        string            // Azure resource group name (env: EXISTING_RESOURCE_GROUP or RESOURCEGROUPNAME, default: ${WorkloadClusterName}-${runID}-resgroup)
+	UseExistingRG            bool              // Deploy into a pre-provisioned resource group that the suite never deletes (env: USE_EXISTING_RG or EXISTING_RESOURCE_GROUP)
 	CAPINamespace            string            // Namespace for CAPI controller (default: "capi-system", or "multicluster-engine" when USE_K8S=true)
 	CAPZNamespace            string            // Namespace for CAPZ/ASO controllers (default: "capz-system", or "multicluster-engine" when USE_K8S=true)
 
+	// WorkloadClusterNames lists every workload cluster in the run (WORKLOAD_CLUSTER_NAMES,
+	// comma-separated), for scale and soak testing of the management cluster. Defaults to
+	// WorkloadClusterName alone; when set, WorkloadClusterName defaults to its first entry.
+	// Phases iterate it through ClusterSet / ForEachCluster.
+	WorkloadClusterNames []string
+
 	// Management cluster mode
 	// ClusterMode specifies the management cluster deployment mode ("kind" or "mce").
 	// - "kind": Deploy local Kind cluster (default behavior)
@@ -508,11 +642,117 @@ type TestConfig struct {
 	// When true, creates a local Kind management cluster with CAPI/CAPZ/ASO controllers.
 	UseKind bool
 
+	// RecreateOnUnhealthy deletes and recreates an existing Kind management cluster
+	// that fails its health check on re-run (RECREATE_ON_UNHEALTHY=true).
+	RecreateOnUnhealthy bool
+
+	// KindConfigPath is an absolute path to a user-supplied kind cluster config (KIND_CONFIG).
+	// When set, it replaces the generated config passed to the deploy script.
+	KindConfigPath string
+
+	// RegistryMirror is an internal registry (host[:port][/path]) that controller and
+	// cert-manager images are pulled from in air-gapped environments (REGISTRY_MIRROR).
+	// It is passed to the deploy script, checked for reachability before deployment, and
+	// recorded in the deployment state.
+	RegistryMirror string
+
+	// MinFreeDiskSpace is the minimum free space in bytes required by the disk-space
+	// preflight (MIN_FREE_DISK_SPACE, e.g. "20G"). Defaults to DefaultMinFreeDiskSpace.
+	MinFreeDiskSpace uint64
+
+	// ResultsKeep is how many timestamped results directories to keep; older ones are pruned
+	// at the start of a run (RESULTS_KEEP). 0 disables pruning.
+	ResultsKeep int
+
+	// FailOnControllerErrors makes TestVerification_ControllerLogSummary fail when controllers
+	// logged more than ControllerErrorThreshold errors (FAIL_ON_CONTROLLER_ERRORS=true).
+	// Default is informational only.
+	FailOnControllerErrors bool
+
+	// ControllerErrorThreshold is the number of controller log errors tolerated before
+	// FailOnControllerErrors fails the run (CONTROLLER_ERROR_THRESHOLD, default 0).
+	ControllerErrorThreshold int
+
+	// ControllerErrorAllowlist is the path to a file of regular expressions, one per line,
+	// matching known-benign controller log errors that are excluded from error counts
+	// (CONTROLLER_ERROR_ALLOWLIST, default: none).
+	ControllerErrorAllowlist string
+
+	// HTTPSProxy, HTTPProxy and NoProxy are passed to every command the suite runs as
+	// HTTPS_PROXY/HTTP_PROXY/NO_PROXY (COMMAND_HTTPS_PROXY, COMMAND_HTTP_PROXY,
+	// COMMAND_NO_PROXY), so az/kubectl/git work behind a proxy without a global shell export.
+	HTTPSProxy string
+	HTTPProxy  string
+	NoProxy    string
+
+	// ProxyFromEnv passes the proxy variables inherited from the environment through to
+	// commands (PROXY_FROM_ENV, default true). When false, only the configured proxy is used.
+	ProxyFromEnv bool
+
+	// CleanupMode controls confirmation for Go-side cleanup helpers (FORCE=1 / DRY_RUN=1).
+	CleanupMode CleanupMode
+
+	// ForceDelete lists the resources still holding finalizers when the test namespace is
+	// stuck in Terminating after deletion times out (FORCE_DELETE=true). Finalizers are
+	// reported, never removed.
+	ForceDelete bool
+
+	// MonitorFormat selects the TestDeployment_MonitorCluster output format (MONITOR_FORMAT).
+	// "json" streams one JSON status object per poll; anything else prints human-readable text.
+	MonitorFormat string
+
+	// OutputFormat selects machine-readable report output (OUTPUT_FORMAT).
+	// "json" also writes TestConfig_DumpEffective's report to effective-config.json.
+	OutputFormat string
+
+	// SkipConsoleCheck skips TestVerification_ConsoleReachable, for environments where the
+	// workload cluster's ingress is not reachable from the test host (SKIP_CONSOLE_CHECK=true).
+	SkipConsoleCheck bool
+
+	// CollectMustGather runs `oc adm must-gather` against the workload cluster when a
+	// verification test fails and archives it in the results directory (COLLECT_MUST_GATHER=true).
+	// MustGatherTimeout bounds the gather (MUST_GATHER_TIMEOUT, default 30m).
+	CollectMustGather bool
+	MustGatherTimeout time.Duration
+
+	// ExpectAzureIdentity makes TestDeployment_VerifyIdentityCreated wait for and require the
+	// cluster's AD application and service principal (EXPECT_AZURE_IDENTITY=true), for the
+	// workload identity flow. Otherwise the test only reports what it finds.
+	ExpectAzureIdentity bool
+
 	// Paths
 	ClusterctlBinPath string
 	ScriptsPath       string
 	GenScriptPath     string
 
+	// ClusterctlSHA256 is the expected SHA-256 of the clusterctl binary (CLUSTERCTL_SHA256,
+	// lowercase hex). When set, tests verify the binary before running it.
+	ClusterctlSHA256 string
+
+	// ClusterctlMinVersion is the oldest clusterctl the monitor test accepts
+	// (CLUSTERCTL_MIN_VERSION, default DefaultClusterctlMinVersion). An older binary is a
+	// warning, or fails the test with ClusterctlVersionStrict (CLUSTERCTL_VERSION_STRICT=true).
+	ClusterctlMinVersion    string
+	ClusterctlVersionStrict bool
+
+	// Strict turns precheck skips into failures (STRICT=true), e.g. when the management
+	// cluster is unreachable at the start of a post-deploy phase. Use it in CI, where a
+	// skipped phase would otherwise hide a broken environment.
+	Strict bool
+
+	// ManifestSchemaLocation is the kubeconform -schema-location for CRD schemas used to
+	// validate generated manifests (KUBECONFORM_SCHEMA_LOCATION). Accepts a URL or path template.
+	ManifestSchemaLocation string
+
+	// KustomizeDir is a kustomization directory layered on the generated files (KUSTOMIZE_DIR).
+	// When set, the apply phase runs `kubectl apply -k` on it instead of applying each file.
+	KustomizeDir string
+
+	// ExistingClusterPolicy is what TestDeployment_01_CheckExistingClusters does when Cluster
+	// CRs matching the current config already exist (EXISTING_CLUSTER_POLICY: allow, warn, fail).
+	// Clusters that do not match the config always fail the check.
+	ExistingClusterPolicy ExistingClusterPolicy
+
 	// Timeouts
 	ClusterDeploymentTimeout time.Duration // CLUSTER_DEPLOYMENT_TIMEOUT: how long the deploy polling loop waits
 	ClusterDeletionTimeout   time.Duration // CLUSTER_DELETION_TIMEOUT: how long the deletion polling loop waits
@@ -521,6 +761,16 @@ type TestConfig struct {
 	ASOControllerTimeout     time.Duration
 	HelmInstallTimeout       time.Duration
 
+	// DeletionPollMaxInterval caps the deletion poll interval (DELETION_POLL_MAX_INTERVAL).
+	DeletionPollMaxInterval time.Duration
+	// DeletionPollBackoffFactor is how much the deletion poll interval grows after each check
+	// (DELETION_POLL_BACKOFF_FACTOR). 1 polls every DeletionPollInitialInterval.
+	DeletionPollBackoffFactor float64
+	// DeletionDeadlines are per-resource-type deadlines within the deletion wait, keyed by the
+	// DeletionType* names (DELETION_DEADLINES, e.g. "MachinePool=20m,ControlPlane=45m"). A type
+	// still present past its deadline fails the test even if the cluster is deleted in time.
+	DeletionDeadlines map[string]time.Duration
+
 	// Infrastructure providers
 	// InfraProviderName is the selected infrastructure provider ("aro" or "rosa").
 	// Set via INFRA_PROVIDER env var. Default: "aro".
@@ -549,11 +799,52 @@ type TestConfig struct {
 	// When true and USE_KUBECONFIG is set, deploys CAPI/provider charts to external cluster.
 	// Default: false
 	DeployCharts bool
+
+	// E2E orchestration configuration
+	// RunE2E enables the TestE2E_* orchestration tests (RUN_E2E=1).
+	RunE2E bool
+
+	// OrderedPhases enables the Test<Phase>_ZZZ_RunPhase runners, which run each phase file's
+	// tests as subtests in a fixed order instead of relying on declaration order (ORDERED_PHASES=true).
+	OrderedPhases bool
+	// E2ETimeout is the single overall deadline for each TestE2E_* test (E2E_TIMEOUT).
+	E2ETimeout time.Duration
+	// SoakIterations is how many create→verify→delete cycles TestE2E_SoakLoop runs
+	// (SOAK_ITERATIONS). 0 disables the soak loop.
+	SoakIterations int
+	// RedactStrict makes TestVerification_NoSecretLeak fail, rather than warn, when results
+	// files contain values that look like leaked secrets (REDACT_STRICT=1).
+	RedactStrict bool
+	// VerifyParallelism is how many of the read-only verification checks
+	// TestVerification_AllChecks runs at once (VERIFY_PARALLELISM). 0 keeps the checks as
+	// separate serial tests and skips TestVerification_AllChecks.
+	VerifyParallelism int
+	// ToolPhases limits TestCheckDependencies_ToolAvailable to the tools the named PHASE values
+	// need (TOOL_PHASES, comma-separated). Empty checks the tools for every phase.
+	ToolPhases []string
+	// ToolMinVersions is the oldest accepted version of each required tool, keyed by command
+	// name (DefaultToolMinVersions overridden by TOOL_MIN_VERSIONS). An older tool is a
+	// warning, or fails the tool check with Strict.
+	ToolMinVersions map[string]string
+
+	// OrphanQueryTimeout bounds each az query in orphaned-resource discovery (ORPHAN_QUERY_TIMEOUT).
+	OrphanQueryTimeout time.Duration
+	// OrphanMinAge is the minimum age for a discovered resource to count as orphaned (ORPHAN_MIN_AGE).
+	// Zero disables age filtering.
+	OrphanMinAge time.Duration
+	// OrphanMatchMode controls how discovery matches resource names to the prefix (ORPHAN_MATCH_MODE).
+	OrphanMatchMode MatchMode
+	// OrphanIncludeUnknownAge lets ExecuteCleanupPlan delete items of unknown age without
+	// prompting (ORPHAN_INCLUDE_UNKNOWN_AGE=true).
+	OrphanIncludeUnknownAge bool
+	// DeleteOrphans enables TestCleanup_DeleteOrphanedResources (DELETE_ORPHANS=true).
+	DeleteOrphans bool
 }
 
 // NewTestConfig creates a new test configuration with defaults
 func NewTestConfig() *TestConfig {
 	useKubeconfig := os.Getenv("USE_KUBECONFIG")
+	proxy := loadProxySettings()
 	deployCharts := parseDeployCharts()
 
 	// Handle CLUSTER_MODE: auto-configure based on cluster mode
@@ -665,7 +956,7 @@ func NewTestConfig() *TestConfig {
 		defaultWorkloadCluster = "capa-tests"
 		testLabelPrefix = "capa-test"
 		clusterYAML = "rosa.yaml"
-		regionEnvVar = "AWS_REGION"
+		regionEnvVar = EnvAWSRegion
 		defaultRegion = "us-east-1"
 	default: // "aro"
 		infraProviderName = "aro" // normalize unknown values
@@ -682,13 +973,13 @@ func NewTestConfig() *TestConfig {
 		defaultWorkloadCluster = "capz-tests"
 		testLabelPrefix = "capz-test"
 		clusterYAML = "aro.yaml"
-		regionEnvVar = "REGION"
+		regionEnvVar = EnvAzureRegion
 		defaultRegion = "uksouth"
 	}
 
 	// Resolve CAPI_USER
 	capiUser := getCAPIUser()
-	environment := GetEnvOrDefault("DEPLOYMENT_ENV", DefaultDeploymentEnv)
+	environment := GetEnvOrDefault(EnvDeploymentEnv, DefaultDeploymentEnv)
 
 	// Resolve CS_CLUSTER_NAME with auto-uniqueness for parallel runs
 	prefix := getClusterNamePrefix(capiUser)
@@ -700,18 +991,26 @@ func NewTestConfig() *TestConfig {
 	}
 
 	// Resolve workload cluster name and resource group name
-	workloadClusterName := GetEnvOrDefault("WORKLOAD_CLUSTER_NAME", defaultWorkloadCluster)
+	workloadClusterNames := parseWorkloadClusterNames()
+	if len(workloadClusterNames) > 0 {
+		defaultWorkloadCluster = workloadClusterNames[0]
+	}
+	workloadClusterName := GetEnvOrDefault(EnvWorkloadClusterName, defaultWorkloadCluster)
+	if len(workloadClusterNames) == 0 {
+		workloadClusterNames = []string{workloadClusterName}
+	}
 	rgName := getResourceGroupName(workloadClusterName, testRunID)
+	mgmtClusterName := GetEnvOrDefault(EnvManagementClusterName, defaultMgmtCluster)
 
 	// Build resource tags for cleanup and ownership tracking (used for both Azure and AWS).
 	// On resume, use cached tags from the deployment state to preserve the original created-at timestamp.
 	resourceTags := cachedResourceTags
 	if resourceTags == nil {
 		resourceTags = map[string]string{
-			"capi-test-user":       capiUser,
-			"capi-test-env":        environment,
-			"capi-test-run-id":     prefix,
-			"capi-test-created-at": time.Now().Format(time.RFC3339),
+			"capi-test-user": capiUser,
+			"capi-test-env":  environment,
+			RunIDTagKey:      prefix,
+			CreatedAtTagKey:  time.Now().Format(time.RFC3339),
 		}
 	}
 
@@ -721,17 +1020,21 @@ func NewTestConfig() *TestConfig {
 		// Repository defaults
 		RepoURL:    GetEnvOrDefault("ARO_REPO_URL", "https://github.com/stolostron/cluster-api-installer"),
 		RepoBranch: GetEnvOrDefault("ARO_REPO_BRANCH", "main"),
+		RepoCommit: os.Getenv("ARO_REPO_COMMIT"),
 		RepoDir:    getDefaultRepoDir(),
+		CloneDepth: parseCloneDepth(),
 
 		// Cluster defaults
-		ManagementClusterName:    GetEnvOrDefault("MANAGEMENT_CLUSTER_NAME", defaultMgmtCluster),
+		ManagementClusterName:    mgmtClusterName,
 		WorkloadClusterName:      workloadClusterName,
+		WorkloadClusterNames:     workloadClusterNames,
 		ClusterNamePrefix:        prefix,
 		NamePrefix:               GetEnvOrDefault("NAME_PREFIX", ""),
-		OCPVersion:               GetEnvOrDefault("OCP_VERSION", "4.20"),
-		OCPVersionMP:             GetEnvOrDefault("OCP_VERSION_MP", "4.20.17"),
+		OCPVersion:               GetEnvOrDefault(EnvOCPVersion, "4.20"),
+		OCPVersionMP:             GetEnvOrDefault(EnvOCPVersionMP, "4.20.17"),
+		MachineSKU:               os.Getenv(EnvMachineSKU),
 		Region:                   GetEnvOrDefault(regionEnvVar, defaultRegion),
-		AzureSubscriptionName:    os.Getenv("AZURE_SUBSCRIPTION_NAME"),
+		AzureSubscriptionName:    os.Getenv(EnvAzureSubscriptionName),
 		Environment:              environment,
 		CAPIUser:                 capiUser,
 		WorkloadClusterNamespace: getWorkloadClusterNamespace(testLabelPrefix),
@@ -739,6 +1042,7 @@ func NewTestConfig() *TestConfig {
 		TestRunID:                testRunID,
 		ResourceTags:             resourceTags,
 		ResourceGroupName:        rgName,
+		UseExistingRG:            parseUseExistingRG(),
 		CAPINamespace:            getControllerNamespace("CAPI_NAMESPACE", "capi-system"),
 		CAPZNamespace:            providerNamespace,
 
@@ -749,13 +1053,54 @@ func NewTestConfig() *TestConfig {
 		UseKubeconfig: useKubeconfig,
 
 		// Kind mode
-		UseKind: os.Getenv("USE_KIND") == "true",
+		UseKind:             os.Getenv("USE_KIND") == "true",
+		RecreateOnUnhealthy: os.Getenv("RECREATE_ON_UNHEALTHY") == "true",
+		KindConfigPath:      parseKindConfigPath(),
+		RegistryMirror:      parseRegistryMirror(),
+		MinFreeDiskSpace:    parseMinFreeDiskSpace(),
+		ResultsKeep:         parseResultsKeep(),
+
+		// Controller log gating
+		FailOnControllerErrors:   os.Getenv("FAIL_ON_CONTROLLER_ERRORS") == "true",
+		ControllerErrorThreshold: parseControllerErrorThreshold(),
+		ControllerErrorAllowlist: os.Getenv("CONTROLLER_ERROR_ALLOWLIST"),
+
+		// Command proxy
+		HTTPSProxy:   proxy.HTTPSProxy,
+		HTTPProxy:    proxy.HTTPProxy,
+		NoProxy:      proxy.NoProxy,
+		ProxyFromEnv: proxy.FromEnv,
+
+		// Cleanup
+		CleanupMode: ParseCleanupMode(os.Getenv("FORCE"), os.Getenv("DRY_RUN")),
+		ForceDelete: os.Getenv("FORCE_DELETE") == "true",
+
+		// Monitoring
+		MonitorFormat: GetEnvOrDefault("MONITOR_FORMAT", "text"),
+		OutputFormat:  GetEnvOrDefault("OUTPUT_FORMAT", "text"),
+
+		// Verification
+		SkipConsoleCheck:    os.Getenv("SKIP_CONSOLE_CHECK") == "true",
+		CollectMustGather:   os.Getenv("COLLECT_MUST_GATHER") == "true",
+		MustGatherTimeout:   parseMustGatherTimeout(),
+		ExpectAzureIdentity: os.Getenv("EXPECT_AZURE_IDENTITY") == "true",
 
 		// Paths
 		ClusterctlBinPath: GetEnvOrDefault("CLUSTERCTL_BIN", "./bin/clusterctl"),
 		ScriptsPath:       GetEnvOrDefault("SCRIPTS_PATH", "./scripts"),
 		GenScriptPath:     GetEnvOrDefault("GEN_SCRIPT_PATH", defaultGenScriptPath),
 
+		ClusterctlSHA256: strings.ToLower(strings.TrimSpace(os.Getenv("CLUSTERCTL_SHA256"))),
+
+		ClusterctlMinVersion:    parseClusterctlMinVersion(),
+		ClusterctlVersionStrict: os.Getenv("CLUSTERCTL_VERSION_STRICT") == "true",
+
+		Strict: os.Getenv("STRICT") == "true",
+
+		ManifestSchemaLocation: GetEnvOrDefault("KUBECONFORM_SCHEMA_LOCATION", DefaultManifestSchemaLocation),
+		KustomizeDir:           os.Getenv("KUSTOMIZE_DIR"),
+		ExistingClusterPolicy:  parseExistingClusterPolicy(),
+
 		// Timeouts
 		ClusterDeploymentTimeout: clusterDeployTimeout,
 		ClusterDeletionTimeout:   parseClusterDeletionTimeout(),
@@ -764,6 +1109,10 @@ func NewTestConfig() *TestConfig {
 		ASOControllerTimeout:     asoTimeout,
 		HelmInstallTimeout:       parseHelmInstallTimeout(),
 
+		DeletionPollMaxInterval:   parseDeletionPollMaxInterval(),
+		DeletionPollBackoffFactor: parseDeletionPollBackoffFactor(),
+		DeletionDeadlines:         parseDeletionDeadlines(),
+
 		// Infrastructure providers
 		InfraProviderName: infraProviderName,
 		InfraProviders:    infraProviders,
@@ -776,6 +1125,24 @@ func NewTestConfig() *TestConfig {
 
 		// Chart deployment
 		DeployCharts: deployCharts,
+
+		// E2E orchestration
+		RunE2E:            os.Getenv("RUN_E2E") == "1",
+		OrderedPhases:     os.Getenv("ORDERED_PHASES") == "true",
+		E2ETimeout:        parseE2ETimeout(),
+		SoakIterations:    parseSoakIterations(),
+		RedactStrict:      os.Getenv("REDACT_STRICT") == "1",
+		VerifyParallelism: parseVerifyParallelism(),
+		ToolPhases:        parseToolPhases(),
+		ToolMinVersions:   parseToolMinVersions(),
+
+		// Cleanup discovery
+		OrphanQueryTimeout: parseOrphanQueryTimeout(),
+		OrphanMinAge:       parseOrphanMinAge(),
+		OrphanMatchMode:    parseOrphanMatchMode(),
+
+		OrphanIncludeUnknownAge: os.Getenv("ORPHAN_INCLUDE_UNKNOWN_AGE") == "true",
+		DeleteOrphans:           os.Getenv("DELETE_ORPHANS") == "true",
 	}
 }
 
@@ -927,6 +1294,176 @@ func parseHelmInstallTimeout() time.Duration {
 	return timeout
 }
 
+// parseDeletionPollMaxInterval parses the DELETION_POLL_MAX_INTERVAL environment variable.
+// Returns the parsed duration or defaults to DefaultDeletionPollMaxInterval.
+func parseDeletionPollMaxInterval() time.Duration {
+	intervalStr := os.Getenv("DELETION_POLL_MAX_INTERVAL")
+	if intervalStr == "" {
+		return DefaultDeletionPollMaxInterval
+	}
+
+	interval, err := time.ParseDuration(intervalStr)
+	if err != nil || interval <= 0 {
+		fmt.Fprintf(os.Stderr, "Warning: invalid DELETION_POLL_MAX_INTERVAL '%s', using default %v\n", intervalStr, DefaultDeletionPollMaxInterval)
+		return DefaultDeletionPollMaxInterval
+	}
+	return interval
+}
+
+// parseDeletionPollBackoffFactor parses the DELETION_POLL_BACKOFF_FACTOR environment variable.
+// Returns the parsed factor or defaults to DefaultDeletionPollBackoffFactor. Factors below 1
+// would shrink the interval and are rejected.
+func parseDeletionPollBackoffFactor() float64 {
+	factorStr := os.Getenv("DELETION_POLL_BACKOFF_FACTOR")
+	if factorStr == "" {
+		return DefaultDeletionPollBackoffFactor
+	}
+
+	factor, err := strconv.ParseFloat(factorStr, 64)
+	if err != nil || !(factor >= 1) || math.IsInf(factor, 1) {
+		fmt.Fprintf(os.Stderr, "Warning: invalid DELETION_POLL_BACKOFF_FACTOR '%s' (must be a number >= 1), using default %g\n", factorStr, DefaultDeletionPollBackoffFactor)
+		return DefaultDeletionPollBackoffFactor
+	}
+	return factor
+}
+
+// DefaultMachinePoolDeletionDeadline is the default DELETION_DEADLINES entry for MachinePool:
+// node pools are removed well before the managed control plane and its cloud resources.
+const DefaultMachinePoolDeletionDeadline = 20 * time.Minute
+
+// parseDeletionDeadlines parses the DELETION_DEADLINES environment variable, a comma-separated
+// list of <type>=<duration> using the DeletionType* names. A duration of 0 removes the deadline
+// for that type. Unset, only MachinePool has a deadline (DefaultMachinePoolDeletionDeadline);
+// an unknown type or invalid duration falls back to that default.
+func parseDeletionDeadlines() map[string]time.Duration {
+	defaults := map[string]time.Duration{DeletionTypeMachinePool: DefaultMachinePoolDeletionDeadline}
+
+	value := os.Getenv("DELETION_DEADLINES")
+	if strings.TrimSpace(value) == "" {
+		return defaults
+	}
+
+	deadlines := map[string]time.Duration{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		typ, durationStr, ok := strings.Cut(entry, "=")
+		typ = strings.TrimSpace(typ)
+		deadline, err := time.ParseDuration(strings.TrimSpace(durationStr))
+		if !ok || err != nil || deadline < 0 || !slices.Contains(DeletionTypes, typ) {
+			fmt.Fprintf(os.Stderr, "Warning: invalid DELETION_DEADLINES entry '%s' (want <type>=<duration>, type one of %s), using default %s=%v\n",
+				entry, strings.Join(DeletionTypes, ", "), DeletionTypeMachinePool, DefaultMachinePoolDeletionDeadline)
+			return defaults
+		}
+		if deadline > 0 {
+			deadlines[typ] = deadline
+		}
+	}
+	return deadlines
+}
+
+// DeletionPollBackoff returns the poll interval backoff used while waiting for cluster deletion.
+func (c *TestConfig) DeletionPollBackoff() PollBackoff {
+	return PollBackoff{
+		Initial: DeletionPollInitialInterval,
+		Max:     c.DeletionPollMaxInterval,
+		Factor:  c.DeletionPollBackoffFactor,
+	}
+}
+
+// parseMustGatherTimeout parses the MUST_GATHER_TIMEOUT environment variable.
+// Returns the parsed duration or defaults to DefaultMustGatherTimeout.
+func parseMustGatherTimeout() time.Duration {
+	timeoutStr := os.Getenv("MUST_GATHER_TIMEOUT")
+	if timeoutStr == "" {
+		return DefaultMustGatherTimeout
+	}
+
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil || timeout <= 0 {
+		fmt.Fprintf(os.Stderr, "Warning: invalid MUST_GATHER_TIMEOUT '%s', using default %v\n", timeoutStr, DefaultMustGatherTimeout)
+		return DefaultMustGatherTimeout
+	}
+	return timeout
+}
+
+// DefaultToolMinVersions is the oldest version of each required tool the suite is known to
+// work with. Older releases fail in ways that are hard to trace back to the tool, such as a
+// kubectl without server-side dry-run. TOOL_MIN_VERSIONS overrides individual entries.
+// clusterctl is not listed: CheckClusterctlVersion checks the binary the monitor test runs
+// against CLUSTERCTL_MIN_VERSION.
+var DefaultToolMinVersions = map[string]string{
+	"kubectl": "v1.25.0",
+	"oc":      "v4.14.0",
+	"kind":    "v0.20.0",
+	"helm":    "v3.10.0",
+	"az":      "v2.50.0",
+	"git":     "v2.20.0",
+	"docker":  "v20.10.0",
+}
+
+// parseToolMinVersions returns DefaultToolMinVersions with the overrides from the
+// TOOL_MIN_VERSIONS environment variable, a comma-separated list of tool=version pairs
+// (e.g. "kubectl=1.28,kind=v0.22.0"). Invalid entries are skipped with a warning, as is
+// clusterctl, whose minimum is CLUSTERCTL_MIN_VERSION.
+func parseToolMinVersions() map[string]string {
+	versions := maps.Clone(DefaultToolMinVersions)
+	for _, entry := range strings.Split(os.Getenv("TOOL_MIN_VERSIONS"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		tool, value, ok := strings.Cut(entry, "=")
+		tool = strings.TrimSpace(tool)
+		version, err := ParseToolVersion(tool, value)
+		if !ok || tool == "" || err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: invalid TOOL_MIN_VERSIONS entry '%s', must be tool=version; ignoring it\n", entry)
+			continue
+		}
+		if tool == "clusterctl" {
+			fmt.Fprintf(os.Stderr, "Warning: TOOL_MIN_VERSIONS entry '%s' is ignored; set CLUSTERCTL_MIN_VERSION instead\n", entry)
+			continue
+		}
+		versions[tool] = version
+	}
+	return versions
+}
+
+// parseClusterctlMinVersion parses the CLUSTERCTL_MIN_VERSION environment variable.
+// Returns the version or defaults to DefaultClusterctlMinVersion when it is unset or not a
+// vMAJOR.MINOR.PATCH version.
+func parseClusterctlMinVersion() string {
+	version := strings.TrimSpace(os.Getenv("CLUSTERCTL_MIN_VERSION"))
+	if version == "" {
+		return DefaultClusterctlMinVersion
+	}
+
+	if _, ok := parseSemver(version); !ok {
+		fmt.Fprintf(os.Stderr, "Warning: invalid CLUSTERCTL_MIN_VERSION '%s', using default %v\n", version, DefaultClusterctlMinVersion)
+		return DefaultClusterctlMinVersion
+	}
+	return version
+}
+
+// parseCloneDepth parses the CLONE_DEPTH environment variable.
+// Returns 0 (full clone) when unset. Logs a warning and falls back to a full clone
+// if the value is not a positive integer.
+func parseCloneDepth() int {
+	depthStr := os.Getenv("CLONE_DEPTH")
+	if depthStr == "" {
+		return 0
+	}
+
+	depth, err := strconv.Atoi(depthStr)
+	if err != nil || depth < 0 {
+		fmt.Fprintf(os.Stderr, "Warning: invalid CLONE_DEPTH '%s', using full clone\n", depthStr)
+		return 0
+	}
+	return depth
+}
+
 // parseMCEAutoEnable parses the MCE_AUTO_ENABLE environment variable.
 // Returns true (default) when using external kubeconfig, false otherwise.
 // Can be explicitly set to "false" to disable auto-enablement.
@@ -956,6 +1493,396 @@ func parseMCEEnablementTimeout() time.Duration {
 	return timeout
 }
 
+// parseE2ETimeout parses the E2E_TIMEOUT environment variable.
+// Returns the parsed duration or defaults to DefaultE2ETimeout.
+// Logs a warning if the provided value is invalid.
+func parseE2ETimeout() time.Duration {
+	timeoutStr := os.Getenv("E2E_TIMEOUT")
+	if timeoutStr == "" {
+		return DefaultE2ETimeout
+	}
+
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil || timeout <= 0 {
+		fmt.Fprintf(os.Stderr, "Warning: invalid E2E_TIMEOUT '%s', using default %v\n", timeoutStr, DefaultE2ETimeout)
+		return DefaultE2ETimeout
+	}
+	return timeout
+}
+
+// parseSoakIterations parses the SOAK_ITERATIONS environment variable.
+// Returns 0 (soak loop disabled) when unset or invalid.
+func parseSoakIterations() int {
+	value := os.Getenv("SOAK_ITERATIONS")
+	if value == "" {
+		return 0
+	}
+	iterations, err := strconv.Atoi(value)
+	if err != nil || iterations < 1 {
+		fmt.Fprintf(os.Stderr, "Warning: invalid SOAK_ITERATIONS '%s', must be a positive integer; soak loop disabled\n", value)
+		return 0
+	}
+	return iterations
+}
+
+// parseVerifyParallelism parses the VERIFY_PARALLELISM environment variable.
+// Returns 0 (checks run serially as separate tests) when unset or invalid.
+func parseVerifyParallelism() int {
+	value := os.Getenv("VERIFY_PARALLELISM")
+	if value == "" {
+		return 0
+	}
+	parallelism, err := strconv.Atoi(value)
+	if err != nil || parallelism < 1 {
+		fmt.Fprintf(os.Stderr, "Warning: invalid VERIFY_PARALLELISM '%s', must be a positive integer; verification checks run serially\n", value)
+		return 0
+	}
+	return parallelism
+}
+
+// parseOrphanQueryTimeout parses the ORPHAN_QUERY_TIMEOUT environment variable.
+// Returns the parsed duration or defaults to DefaultOrphanQueryTimeout.
+// Zero or negative values are rejected since they would cancel every query immediately.
+func parseOrphanQueryTimeout() time.Duration {
+	timeoutStr := os.Getenv("ORPHAN_QUERY_TIMEOUT")
+	if timeoutStr == "" {
+		return DefaultOrphanQueryTimeout
+	}
+
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil || timeout <= 0 {
+		fmt.Fprintf(os.Stderr, "Warning: invalid ORPHAN_QUERY_TIMEOUT '%s', using default %v\n", timeoutStr, DefaultOrphanQueryTimeout)
+		return DefaultOrphanQueryTimeout
+	}
+	return timeout
+}
+
+// parseOrphanMinAge parses the ORPHAN_MIN_AGE environment variable.
+// Returns the parsed duration or defaults to DefaultOrphanMinAge.
+// Zero is accepted and disables age filtering; negative values are rejected.
+func parseOrphanMinAge() time.Duration {
+	ageStr := os.Getenv("ORPHAN_MIN_AGE")
+	if ageStr == "" {
+		return DefaultOrphanMinAge
+	}
+
+	age, err := time.ParseDuration(ageStr)
+	if err != nil || age < 0 {
+		fmt.Fprintf(os.Stderr, "Warning: invalid ORPHAN_MIN_AGE '%s', using default %v\n", ageStr, DefaultOrphanMinAge)
+		return DefaultOrphanMinAge
+	}
+	return age
+}
+
+// parseOrphanMatchMode parses the ORPHAN_MATCH_MODE environment variable.
+// Returns the parsed mode or defaults to MatchModePrefix.
+func parseOrphanMatchMode() MatchMode {
+	value := os.Getenv("ORPHAN_MATCH_MODE")
+	mode, err := ParseMatchMode(value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: invalid ORPHAN_MATCH_MODE '%s', using default %s\n", value, MatchModePrefix)
+	}
+	return mode
+}
+
+// parseExistingClusterPolicy parses the EXISTING_CLUSTER_POLICY environment variable.
+// Returns the parsed policy or defaults to ExistingClusterAllow.
+func parseExistingClusterPolicy() ExistingClusterPolicy {
+	value := os.Getenv("EXISTING_CLUSTER_POLICY")
+	policy, err := ParseExistingClusterPolicy(value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: invalid EXISTING_CLUSTER_POLICY '%s', using default %s\n", value, ExistingClusterAllow)
+	}
+	return policy
+}
+
+// parseKindConfigPath parses the KIND_CONFIG environment variable.
+// Relative paths are resolved against the working directory at startup, since the
+// deploy phase changes into the repository directory before running the script.
+func parseKindConfigPath() string {
+	path := os.Getenv("KIND_CONFIG")
+	if path == "" {
+		return ""
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: cannot resolve KIND_CONFIG '%s', using it as-is: %v\n", path, err)
+		return path
+	}
+	return absPath
+}
+
+// parseRegistryMirror parses the REGISTRY_MIRROR environment variable into
+// host[:port][/path] form. A URL scheme and trailing slash are dropped, since image
+// references never carry them.
+func parseRegistryMirror() string {
+	mirror := strings.TrimSpace(os.Getenv("REGISTRY_MIRROR"))
+	mirror = strings.TrimPrefix(strings.TrimPrefix(mirror, "https://"), "http://")
+	return strings.TrimRight(mirror, "/")
+}
+
+// parseMinFreeDiskSpace parses the MIN_FREE_DISK_SPACE environment variable.
+// Accepts sizes like "20G", "512MiB", or a plain byte count.
+func parseMinFreeDiskSpace() uint64 {
+	value := os.Getenv("MIN_FREE_DISK_SPACE")
+	if value == "" {
+		return DefaultMinFreeDiskSpace
+	}
+	size, err := ParseByteSize(value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: invalid MIN_FREE_DISK_SPACE '%s', using default %s\n", value, FormatBytes(DefaultMinFreeDiskSpace))
+		return DefaultMinFreeDiskSpace
+	}
+	return size
+}
+
+// parseResultsKeep parses the RESULTS_KEEP environment variable.
+// Returns 0 (no pruning) when unset or invalid.
+func parseResultsKeep() int {
+	value := os.Getenv("RESULTS_KEEP")
+	if value == "" {
+		return 0
+	}
+	keep, err := strconv.Atoi(value)
+	if err != nil || keep < 1 {
+		fmt.Fprintf(os.Stderr, "Warning: invalid RESULTS_KEEP '%s', must be a positive integer; results will not be pruned\n", value)
+		return 0
+	}
+	return keep
+}
+
+// PhaseSelector maps a PHASE value to the phase file whose tests it selects.
+type PhaseSelector struct {
+	Name     string   // PHASE value
+	File     string   // phase test file
+	Prefixes []string // top-level test name prefixes declared in File
+	Runner   string   // ordered runner selected instead when ORDERED_PHASES=true
+}
+
+// PhaseSelectors is the PHASE mapping, in run order. TestPhaseSelectors checks each entry
+// selects every test in its file and none from another.
+var PhaseSelectors = []PhaseSelector{
+	{"prereq", "01_check_dependencies_test.go", []string{"TestCheckDependencies_"}, "TestCheckDependencies_ZZZ_RunPhase"},
+	{"setup", "02_setup_test.go", []string{"TestSetup_"}, "TestSetup_ZZZ_RunPhase"},
+	{"kind", "03_cluster_test.go", []string{"TestExternalCluster_", "TestKindCluster_"}, "TestKindCluster_ZZZ_RunPhase"},
+	{"generate", "04_generate_yamls_test.go", []string{"TestInfrastructure_"}, "TestInfrastructure_ZZZ_RunPhase"},
+	{"deploy", "05_deploy_crs_test.go", []string{"TestDeployment_"}, "TestDeployment_ZZZ_RunPhase"},
+	{"verify", "06_verification_test.go", []string{"TestVerification_"}, "TestVerification_ZZZ_RunPhase"},
+	{"delete", "07_deletion_test.go", []string{"TestDeletion_"}, "TestDeletion_ZZZ_RunPhase"},
+	{"cleanup", "08_cleanup_test.go", []string{"TestCleanup_"}, "TestCleanup_ZZZ_RunPhase"},
+	{"teardown", "09_teardown_test.go", []string{"TestTeardown_"}, "TestTeardown_ZZZ_RunPhase"},
+}
+
+// PhaseRunPattern returns the go test -run pattern selecting the tests of the named phase
+// (PHASE). With ordered set it selects only the phase's ZZZ_RunPhase runner, which runs the
+// same tests as subtests in a fixed order.
+func PhaseRunPattern(phase string, ordered bool) (string, error) {
+	var names []string
+	for _, sel := range PhaseSelectors {
+		if sel.Name != phase {
+			names = append(names, sel.Name)
+			continue
+		}
+		if ordered {
+			return "^" + sel.Runner + "$", nil
+		}
+		return "^(" + strings.Join(sel.Prefixes, "|") + ")", nil
+	}
+	return "", fmt.Errorf("unknown PHASE '%s' (valid: %s)", phase, strings.Join(names, ", "))
+}
+
+// PhaseTools lists the CLI tools the tests of one phase run.
+type PhaseTools struct {
+	Tools    []string // needed with any management cluster
+	KindOnly []string // needed only with a Kind management cluster (USE_KUBECONFIG unset)
+	Provider bool     // also needs each provider's RequiredTools ("az", "aws")
+}
+
+// PhaseRequiredTools maps each PhaseSelectors name to the tools its phase needs, so a partial
+// run (e.g. generate only, on a host without docker) checks just what it will use. The union
+// over every phase is the full prerequisite set.
+var PhaseRequiredTools = map[string]PhaseTools{
+	"prereq":   {Tools: []string{"go", "xmllint"}},
+	"setup":    {Tools: []string{"git"}},
+	"kind":     {Tools: []string{"kubectl", "helm", "envsubst"}, KindOnly: []string{"docker", "kind"}},
+	"generate": {Tools: []string{"kubectl", "envsubst"}, Provider: true},
+	"deploy":   {Tools: []string{"kubectl"}, Provider: true},
+	"verify":   {Tools: []string{"kubectl", "oc"}},
+	"delete":   {Tools: []string{"kubectl"}, Provider: true},
+	"cleanup":  {Tools: []string{"kubectl"}, Provider: true},
+	"teardown": {Tools: []string{"kubectl"}},
+}
+
+// RequiredToolsForPhases returns the deduplicated CLI tools the named phases need, in
+// PhaseSelectors order, taking the management cluster mode and providers into account.
+// With no phases it returns the tools for every phase.
+func (c *TestConfig) RequiredToolsForPhases(phases ...string) ([]string, error) {
+	var known []string
+	for _, sel := range PhaseSelectors {
+		known = append(known, sel.Name)
+	}
+	for _, phase := range phases {
+		if !slices.Contains(known, phase) {
+			return nil, fmt.Errorf("unknown phase '%s' (valid: %s)", phase, strings.Join(known, ", "))
+		}
+	}
+
+	var tools []string
+	add := func(names ...string) {
+		for _, name := range names {
+			if !slices.Contains(tools, name) {
+				tools = append(tools, name)
+			}
+		}
+	}
+	for _, name := range known {
+		if len(phases) > 0 && !slices.Contains(phases, name) {
+			continue
+		}
+		pt := PhaseRequiredTools[name]
+		if !c.IsExternalCluster() {
+			add(pt.KindOnly...)
+		}
+		add(pt.Tools...)
+		if pt.Provider {
+			add(c.AllRequiredTools()...)
+		}
+	}
+	return tools, nil
+}
+
+// parseToolPhases parses the comma-separated TOOL_PHASES environment variable, dropping
+// blanks and duplicates. Returns nil (every phase) when unset.
+func parseToolPhases() []string {
+	var phases []string
+	for _, phase := range strings.Split(os.Getenv("TOOL_PHASES"), ",") {
+		phase = strings.TrimSpace(phase)
+		if phase != "" && !slices.Contains(phases, phase) {
+			phases = append(phases, phase)
+		}
+	}
+	return phases
+}
+
+// parseWorkloadClusterNames parses the comma-separated WORKLOAD_CLUSTER_NAMES environment
+// variable, dropping blanks and duplicates. Returns nil when unset.
+func parseWorkloadClusterNames() []string {
+	var names []string
+	for _, name := range strings.Split(os.Getenv("WORKLOAD_CLUSTER_NAMES"), ",") {
+		name = strings.TrimSpace(name)
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// ClusterSet is the set of workload clusters a run manages, one TestConfig per cluster.
+// Each member is a copy of the run's config with WorkloadClusterName set to that cluster,
+// so its output directory, provisioned names, kubeconfig path and resource group are its
+// own; everything else (namespace, management cluster) is shared.
+type ClusterSet []*TestConfig
+
+// ClusterSet returns one TestConfig per name in WorkloadClusterNames, in order. The run's
+// own cluster keeps the resolved ResourceGroupName; every other cluster gets its generated
+// ${name}-${runID}-resgroup, since deleting a cluster deletes its group. A pre-provisioned
+// group (EXISTING_RESOURCE_GROUP) is never deleted and stays shared.
+func (c *TestConfig) ClusterSet() ClusterSet {
+	names := c.WorkloadClusterNames
+	if len(names) == 0 {
+		names = []string{c.WorkloadClusterName}
+	}
+
+	set := make(ClusterSet, 0, len(names))
+	for _, name := range names {
+		cluster := *c
+		cluster.WorkloadClusterName = name
+		cluster.WorkloadClusterNames = []string{name}
+		if name != c.WorkloadClusterName && !c.UseExistingRG {
+			cluster.ResourceGroupName = defaultResourceGroupName(name, c.TestRunID)
+		}
+		set = append(set, &cluster)
+	}
+	return set
+}
+
+// Names returns the workload cluster names in the set.
+func (s ClusterSet) Names() []string {
+	names := make([]string, 0, len(s))
+	for _, cluster := range s {
+		names = append(names, cluster.WorkloadClusterName)
+	}
+	return names
+}
+
+// loadProxySettings reads the proxy configuration for commands run by the suite from
+// COMMAND_HTTPS_PROXY, COMMAND_HTTP_PROXY, COMMAND_NO_PROXY and PROXY_FROM_ENV.
+func loadProxySettings() ProxySettings {
+	return ProxySettings{
+		HTTPSProxy: os.Getenv("COMMAND_HTTPS_PROXY"),
+		HTTPProxy:  os.Getenv("COMMAND_HTTP_PROXY"),
+		NoProxy:    os.Getenv("COMMAND_NO_PROXY"),
+		FromEnv:    parseProxyFromEnv(),
+	}
+}
+
+// parseProxyFromEnv parses the PROXY_FROM_ENV environment variable.
+// Returns true (inherit proxy variables) when unset or invalid.
+func parseProxyFromEnv() bool {
+	value := os.Getenv("PROXY_FROM_ENV")
+	if value == "" {
+		return true
+	}
+	fromEnv, err := strconv.ParseBool(value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: invalid PROXY_FROM_ENV '%s', must be true or false; inheriting proxy variables\n", value)
+		return true
+	}
+	return fromEnv
+}
+
+// ProxySettings returns the proxy configuration applied to commands run by the suite.
+func (c *TestConfig) ProxySettings() ProxySettings {
+	return ProxySettings{
+		HTTPSProxy: c.HTTPSProxy,
+		HTTPProxy:  c.HTTPProxy,
+		NoProxy:    c.NoProxy,
+		FromEnv:    c.ProxyFromEnv,
+	}
+}
+
+// parseControllerErrorThreshold parses the CONTROLLER_ERROR_THRESHOLD environment variable.
+// Returns 0 (any error fails when FAIL_ON_CONTROLLER_ERRORS is set) when unset or invalid.
+func parseControllerErrorThreshold() int {
+	value := os.Getenv("CONTROLLER_ERROR_THRESHOLD")
+	if value == "" {
+		return 0
+	}
+	threshold, err := strconv.Atoi(value)
+	if err != nil || threshold < 0 {
+		fmt.Fprintf(os.Stderr, "Warning: invalid CONTROLLER_ERROR_THRESHOLD '%s', using 0\n", value)
+		return 0
+	}
+	return threshold
+}
+
+// parseUseExistingRG reports whether the suite deploys into a pre-provisioned resource group.
+// EXISTING_RESOURCE_GROUP implies it. USE_EXISTING_RG=true also needs RESOURCEGROUPNAME,
+// since a generated per-run name can never refer to an existing group.
+func parseUseExistingRG() bool {
+	if os.Getenv("EXISTING_RESOURCE_GROUP") != "" {
+		return true
+	}
+	if os.Getenv("USE_EXISTING_RG") != "true" {
+		return false
+	}
+	if os.Getenv(EnvResourceGroupName) == "" {
+		fmt.Fprintf(os.Stderr, "Warning: USE_EXISTING_RG=true but neither EXISTING_RESOURCE_GROUP nor RESOURCEGROUPNAME is set, ignoring\n")
+		return false
+	}
+	return true
+}
+
 // parseDeployCharts parses the DEPLOY_CHARTS environment variable.
 // Returns true if DEPLOY_CHARTS=true, false otherwise.
 // Default: false
@@ -1063,10 +1990,26 @@ func (c *TestConfig) GetExpectedFiles() []string {
 	}
 }
 
+// GetApplyFiles returns GetExpectedFiles sorted so every file comes after the files the
+// provider's ApplyOrder says it depends on. The apply tests use it instead of relying on
+// the order of the ExpectedFiles literal.
+func (c *TestConfig) GetApplyFiles() ([]string, error) {
+	var order ApplyOrder
+	if len(c.InfraProviders) > 0 {
+		order = c.InfraProviders[0].ApplyOrder
+	}
+	return SortByApplyOrder(c.GetExpectedFiles(), order)
+}
+
 // SharedTempDir returns a directory suitable for storing temporary files that
 // must persist across CI steps. In Prow, SHARED_DIR is a volume shared between
 // all step containers. Outside Prow, falls back to os.TempDir().
 func (c *TestConfig) SharedTempDir() string {
+	return sharedTempDir()
+}
+
+// sharedTempDir implements SharedTempDir for helpers that have no TestConfig.
+func sharedTempDir() string {
 	if dir := GetEnvOrDefault("SHARED_DIR", ""); dir != "" {
 		return dir
 	}
@@ -1083,6 +2026,28 @@ func (c *TestConfig) GetKubeContext() string {
 	return fmt.Sprintf("kind-%s", c.ManagementClusterName)
 }
 
+// KindClusterName returns the management cluster name, warning once per process that the
+// old name is still in use.
+//
+// Deprecated: use ManagementClusterName.
+func (c *TestConfig) KindClusterName() string {
+	kindClusterNameWarnOnce.Do(func() {
+		fmt.Fprintf(os.Stderr, "Warning: TestConfig.KindClusterName() is deprecated, use ManagementClusterName\n")
+	})
+	return c.ManagementClusterName
+}
+
+// ClusterName returns the workload cluster name, warning once per process that the old
+// name is still in use.
+//
+// Deprecated: use WorkloadClusterName.
+func (c *TestConfig) ClusterName() string {
+	clusterNameWarnOnce.Do(func() {
+		fmt.Fprintf(os.Stderr, "Warning: TestConfig.ClusterName() is deprecated, use WorkloadClusterName\n")
+	})
+	return c.WorkloadClusterName
+}
+
 // AllControllers returns all infrastructure controllers across all providers,
 // prepended with the CAPI core controller. Used for version queries, log collection,
 // and readiness checks that need to iterate over every controller.
@@ -1096,6 +2061,16 @@ func (c *TestConfig) AllControllers() []ControllerDef {
 	return controllers
 }
 
+// AllRequiredCRDs returns the CRDs the generated files need: the CAPI core CRDs followed by
+// each provider's RequiredCRDs.
+func (c *TestConfig) AllRequiredCRDs() []string {
+	crds := slices.Clone(CAPICoreCRDs)
+	for _, p := range c.InfraProviders {
+		crds = append(crds, p.RequiredCRDs...)
+	}
+	return crds
+}
+
 // AllWebhooks returns all webhooks across all providers,
 // prepended with the CAPI core webhook.
 func (c *TestConfig) AllWebhooks() []WebhookDef {
@@ -1173,3 +2148,365 @@ func (c *TestConfig) AllRequiredScripts() []string {
 	}
 	return scripts
 }
+
+// EnvTemplateFile is the file GenerateEnvTemplate writes at the repository root.
+const EnvTemplateFile = ".env.example"
+
+// EnvVarDoc documents one environment variable the suite reads, for GenerateEnvTemplate.
+type EnvVarDoc struct {
+	Group       string // section heading in the template
+	Name        string
+	Default     string // value used when unset; empty means unset or derived
+	Description string // one line
+}
+
+// envVarDocs is the source of truth for GenerateEnvTemplate. Every variable NewTestConfig
+// reads must be listed (TestEnvVarDocs_CoversConfig checks this against config.go), plus the
+// credentials the providers read from the environment directly.
+var envVarDocs = []EnvVarDoc{
+	{"Repository", "ARO_REPO_URL", "https://github.com/stolostron/cluster-api-installer", "cluster-api-installer repository to clone"},
+	{"Repository", "ARO_REPO_BRANCH", "main", "Branch of the repository to use"},
+	{"Repository", "ARO_REPO_COMMIT", "", "Commit SHA to pin the repository to (default: branch HEAD)"},
+	{"Repository", "ARO_REPO_DIR", "", "Local clone directory (default: <temp dir>/cluster-api-installer-aro)"},
+	{"Repository", "CLONE_DEPTH", "", "Shallow clone depth for git clone --depth (default: full clone)"},
+	{"Repository", "SCRIPTS_PATH", "./scripts", "Scripts directory inside the repository"},
+	{"Repository", "GEN_SCRIPT_PATH", "", "YAML generation script (default: ./scripts/aro-hcp/gen.sh or ./scripts/rosa-hcp/gen.sh)"},
+	{"Repository", "CLUSTERCTL_BIN", "./bin/clusterctl", "clusterctl binary, relative to the repository"},
+	{"Repository", "CLUSTERCTL_SHA256", "", "Expected SHA-256 of the clusterctl binary (default: not checked)"},
+	{"Repository", "CLUSTERCTL_MIN_VERSION", DefaultClusterctlMinVersion, "Oldest clusterctl version accepted"},
+	{"Repository", "CLUSTERCTL_VERSION_STRICT", "", "Set to true to fail instead of warn on an older clusterctl"},
+	{"Repository", "KUBECONFORM_SCHEMA_LOCATION", DefaultManifestSchemaLocation, "CRD schema location for kubeconform manifest validation"},
+	{"Repository", "KUSTOMIZE_DIR", "", "Kustomization directory applied with kubectl apply -k instead of the generated files"},
+
+	{"Infrastructure provider", "INFRA_PROVIDER", "aro", "Infrastructure provider: aro or rosa"},
+
+	{"Azure credentials (ARO)", "AZURE_CLIENT_ID", "", "Service principal client ID (or run az login)"},
+	{"Azure credentials (ARO)", "AZURE_CLIENT_SECRET", "", "Service principal client secret"},
+	{"Azure credentials (ARO)", "AZURE_TENANT_ID", "", "Azure tenant ID"},
+	{"Azure credentials (ARO)", "AZURE_SUBSCRIPTION_ID", "", "Azure subscription ID"},
+	{"Azure credentials (ARO)", "AZURE_SUBSCRIPTION_NAME", "", "Azure subscription to select by name"},
+	{"Azure credentials (ARO)", "ASO_CREDENTIAL_NAME", "aso-credential", "Name of the ASO credential secret in the generated YAML"},
+
+	{"AWS credentials (ROSA)", "AWS_ACCESS_KEY_ID", "", "AWS access key ID"},
+	{"AWS credentials (ROSA)", "AWS_SECRET_ACCESS_KEY", "", "AWS secret access key"},
+	{"AWS credentials (ROSA)", "OCM_API_URL", "", "OpenShift Cluster Manager API URL"},
+	{"AWS credentials (ROSA)", "OCM_CLIENT_ID", "", "OCM OAuth client ID"},
+	{"AWS credentials (ROSA)", "OCM_CLIENT_SECRET", "", "OCM OAuth client secret"},
+
+	{"Cluster", "MANAGEMENT_CLUSTER_NAME", "", "Management cluster name (default: capz-tests-stage for ARO, capa-tests-stage for ROSA)"},
+	{"Cluster", "WORKLOAD_CLUSTER_NAME", "", "Workload cluster name (default: capz-tests for ARO, capa-tests for ROSA)"},
+	{"Cluster", "WORKLOAD_CLUSTER_NAMES", "", "Comma-separated workload cluster names for scale/soak testing"},
+	{"Cluster", "WORKLOAD_CLUSTER_NAMESPACE", "", "Namespace for workload cluster resources (default: generated per run)"},
+	{"Cluster", "WORKLOAD_CLUSTER_NAMESPACE_PREFIX", "", "Prefix of the generated namespace (default: capz-test for ARO, capa-test for ROSA)"},
+	{"Cluster", "CS_CLUSTER_NAME", "", "Cluster name prefix for YAML generation (default: <CAPI_USER>-<random>)"},
+	{"Cluster", "NAME_PREFIX", "", "Prefix for Azure resource names such as Key Vault and node pools"},
+	{"Cluster", "CAPI_USER", DefaultCAPIUser, "User identifier for resource names and tags (default: $USER, then cate)"},
+	{"Cluster", "DEPLOYMENT_ENV", DefaultDeploymentEnv, "Deployment environment used in tags and domain prefixes"},
+	{"Cluster", "OCP_VERSION", "4.20", "OpenShift version (major.minor)"},
+	{"Cluster", "OCP_VERSION_MP", "4.20.17", "Full OpenShift version for MachinePool workers"},
+	{"Cluster", "MACHINE_SKU", "", "VM size for MachinePool workers (default: generator default)"},
+	{"Cluster", "REGION", "uksouth", "Azure region (ARO)"},
+	{"Cluster", "AWS_REGION", "us-east-1", "AWS region (ROSA)"},
+	{"Cluster", "RESOURCEGROUPNAME", "", "Azure resource group name (default: generated per run)"},
+	{"Cluster", "EXISTING_RESOURCE_GROUP", "", "Pre-provisioned resource group to deploy into"},
+	{"Cluster", "USE_EXISTING_RG", "", "Set to true to treat RESOURCEGROUPNAME as pre-provisioned"},
+	{"Cluster", "EXISTING_CLUSTER_POLICY", string(ExistingClusterAllow), "What to do when Cluster CRs matching the config already exist before deploy: allow, warn or fail"},
+	{"Cluster", "EXPECT_AZURE_IDENTITY", "", "Set to true to require the cluster's AD application and service principal at deploy time (ARO workload identity)"},
+
+	{"Management cluster", "CLUSTER_MODE", "", "Management cluster mode: kind or mce"},
+	{"Management cluster", "USE_KIND", "false", "Set to true to deploy a Kind management cluster"},
+	{"Management cluster", "USE_KUBECONFIG", "", "Kubeconfig of an existing management cluster (external cluster mode)"},
+	{"Management cluster", "USE_K8S", "", "Set to true to use the multicluster-engine namespace for all controllers"},
+	{"Management cluster", "CAPI_NAMESPACE", "capi-system", "Namespace of the CAPI controller"},
+	{"Management cluster", "CAPZ_NAMESPACE", "capz-system", "Namespace of the CAPZ and ASO controllers (ARO)"},
+	{"Management cluster", "CAPA_NAMESPACE", "capa-system", "Namespace of the CAPA controller (ROSA)"},
+	{"Management cluster", "DEPLOY_CHARTS", "", "Set to true to deploy the Helm charts to an external cluster"},
+	{"Management cluster", "MCE_AUTO_ENABLE", "", "Enable missing MCE CAPI/CAPZ components (default: true with USE_KUBECONFIG)"},
+	{"Management cluster", "MCE_ENABLEMENT_TIMEOUT", DefaultMCEEnablementTimeout.String(), "Wait after enabling MCE components"},
+	{"Management cluster", "RECREATE_ON_UNHEALTHY", "false", "Recreate an unhealthy existing Kind cluster"},
+	{"Management cluster", "KIND_CONFIG", "", "Kind cluster config YAML used instead of the generated one"},
+	{"Management cluster", "REGISTRY_MIRROR", "", "Internal registry (host[:port][/path]) to pull controller images from"},
+	{"Management cluster", "MIN_FREE_DISK_SPACE", FormatBytes(DefaultMinFreeDiskSpace), "Minimum free disk space required by the preflight"},
+
+	{"Timeouts", "CLUSTER_DEPLOYMENT_TIMEOUT", DefaultClusterDeploymentTimeout.String(), "Wait for the workload cluster to become ready"},
+	{"Timeouts", "CLUSTER_DELETION_TIMEOUT", DefaultClusterDeletionTimeout.String(), "Wait for the workload cluster to be deleted"},
+	{"Timeouts", "DEPLOYMENT_TIMEOUT", "", "Deprecated fallback for the two timeouts above"},
+	{"Timeouts", "DEPLOYMENT_STALL_TIMEOUT", DefaultDeploymentStallTimeout.String(), "Fail early when the deployment makes no progress for this long (0 disables)"},
+	{"Timeouts", "DELETION_POLL_MAX_INTERVAL", DefaultDeletionPollMaxInterval.String(), "Cap on the deletion poll interval"},
+	{"Timeouts", "DELETION_POLL_BACKOFF_FACTOR", strconv.FormatFloat(DefaultDeletionPollBackoffFactor, 'g', -1, 64), "Growth of the deletion poll interval after each check"},
+	{"Timeouts", "DELETION_DEADLINES", fmt.Sprintf("%s=%v", DeletionTypeMachinePool, DefaultMachinePoolDeletionDeadline), "Per-resource-type deletion deadlines as <type>=<duration> pairs"},
+	{"Timeouts", "ASO_CONTROLLER_TIMEOUT", DefaultASOControllerTimeout.String(), "Wait for the ASO controller to become ready"},
+	{"Timeouts", "HELM_INSTALL_TIMEOUT", DefaultHelmInstallTimeout.String(), "Timeout for Helm installs in the deploy scripts"},
+
+	{"Test behavior", "STRICT", "", "Set to true to fail instead of skip when a precheck finds a broken environment"},
+	{"Test behavior", "RESULTS_KEEP", "", "Keep only the newest N results directories (default: keep all)"},
+	{"Test behavior", "FAIL_ON_CONTROLLER_ERRORS", "", "Set to true to fail when controllers log more errors than the threshold"},
+	{"Test behavior", "CONTROLLER_ERROR_THRESHOLD", "0", "Controller log errors tolerated with FAIL_ON_CONTROLLER_ERRORS"},
+	{"Test behavior", "CONTROLLER_ERROR_ALLOWLIST", "", "File of regular expressions for known-benign controller errors"},
+	{"Test behavior", "COMMAND_HTTPS_PROXY", "", "HTTPS proxy passed to every command the suite runs"},
+	{"Test behavior", "COMMAND_HTTP_PROXY", "", "HTTP proxy passed to every command the suite runs"},
+	{"Test behavior", "COMMAND_NO_PROXY", "", "Hosts that bypass the command proxy"},
+	{"Test behavior", "PROXY_FROM_ENV", "true", "Set to false to stop commands inheriting HTTPS_PROXY/HTTP_PROXY/NO_PROXY"},
+	{"Test behavior", "MONITOR_FORMAT", "text", "TestDeployment_MonitorCluster output: text or json"},
+	{"Test behavior", "OUTPUT_FORMAT", "text", "Set to json to also write effective-config.json"},
+	{"Test behavior", "SKIP_CONSOLE_CHECK", "", "Set to true to skip the web console reachability check"},
+	{"Test behavior", "COLLECT_MUST_GATHER", "", "Set to true to run oc adm must-gather when a verification test fails"},
+	{"Test behavior", "MUST_GATHER_TIMEOUT", DefaultMustGatherTimeout.String(), "Time limit for must-gather"},
+	{"Test behavior", "RUN_E2E", "", "Set to 1 to enable the TestE2E_* orchestration tests"},
+	{"Test behavior", "PHASE", "", "Run only one phase's tests: prereq, setup, kind, generate, deploy, verify, delete, cleanup or teardown (ignored when -run is given)"},
+	{"Test behavior", "ORDERED_PHASES", "", "Set to true to enable the per-phase *_ZZZ_RunPhase runners (run them with -run '_ZZZ_RunPhase$')"},
+	{"Test behavior", "E2E_TIMEOUT", DefaultE2ETimeout.String(), "Overall deadline for each TestE2E_* test"},
+	{"Test behavior", "SOAK_ITERATIONS", "", "Create/verify/delete cycles for TestE2E_SoakLoop"},
+	{"Test behavior", "REDACT_STRICT", "", "Set to 1 to fail TestVerification_NoSecretLeak when results files contain subscription IDs or base64 blobs"},
+	{"Test behavior", "TOOL_PHASES", "", "Comma-separated PHASE values whose tools TestCheckDependencies_ToolAvailable requires (e.g. generate); unset requires every phase's tools"},
+	{"Test behavior", "TOOL_MIN_VERSIONS", "", "Comma-separated tool=version overrides of the minimum tool versions (e.g. kubectl=1.28,kind=0.22); older tools warn, or fail with STRICT=true"},
+	{"Test behavior", "VERIFY_PARALLELISM", "", "Run the node/version/operator/health checks concurrently in TestVerification_AllChecks, this many at a time"},
+	{"Test behavior", "SHARED_DIR", "", "Directory for files shared between CI steps (default: system temp dir)"},
+
+	{"Cleanup", "DRY_RUN", "", "Set to 1 to only report what cleanup tests would delete"},
+	{"Cleanup", "FORCE", "", "Set to 1 to delete without prompting in cleanup tests"},
+	{"Cleanup", "FORCE_DELETE", "", "Set to true to list finalizer holders when a test namespace is stuck Terminating"},
+	{"Cleanup", "ORPHAN_QUERY_TIMEOUT", DefaultOrphanQueryTimeout.String(), "Timeout for each az query in the orphaned-resource check"},
+	{"Cleanup", "ORPHAN_MIN_AGE", DefaultOrphanMinAge.String(), "Minimum age for a resource to count as orphaned"},
+	{"Cleanup", "ORPHAN_MATCH_MODE", "prefix", "Orphan name matching: exact, prefix or contains"},
+	{"Cleanup", "ORPHAN_INCLUDE_UNKNOWN_AGE", "", "Set to true to delete orphans of unknown age without prompting"},
+	{"Cleanup", "DELETE_ORPHANS", "", "Set to true to let TestCleanup_DeleteOrphanedResources delete orphaned Azure resources"},
+}
+
+// FormatEnvTemplate renders envVarDocs as a .env file with every variable commented out at
+// its default, grouped under section headings.
+func FormatEnvTemplate() string {
+	var sb strings.Builder
+	sb.WriteString("# Environment variables read by the capi-tests suite.\n")
+	sb.WriteString("# Generated by GenerateEnvTemplate (make env-template) - do not edit by hand.\n")
+	sb.WriteString("# Copy to .env, uncomment what you need, and load it with: set -a; . ./.env; set +a\n")
+
+	group := ""
+	for _, v := range envVarDocs {
+		if v.Group != group {
+			group = v.Group
+			fmt.Fprintf(&sb, "\n# --- %s ---\n", group)
+		}
+		fmt.Fprintf(&sb, "\n# %s\n# %s=%s\n", v.Description, v.Name, v.Default)
+	}
+	return sb.String()
+}
+
+// GenerateEnvTemplate writes FormatEnvTemplate to path (normally EnvTemplateFile at the
+// repository root).
+func GenerateEnvTemplate(path string) error {
+	if err := os.WriteFile(path, []byte(FormatEnvTemplate()), 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// EffectiveConfigFile is the file TestConfig_DumpEffective writes when OUTPUT_FORMAT=json.
+const EffectiveConfigFile = "effective-config.json"
+
+// ConfigField is one resolved TestConfig field and where its value came from.
+type ConfigField struct {
+	Name   string `json:"-"`
+	Value  string `json:"value"`
+	Source string `json:"source"` // "env (VAR)", "default", or "derived"
+}
+
+// configFieldEnvVars maps TestConfig fields to the environment variables that can set them,
+// in precedence order. Fields not listed are derived from other settings. Region is resolved
+// through RegionEnvVar since its variable depends on the provider.
+var configFieldEnvVars = map[string][]string{
+	"RepoURL":                   {"ARO_REPO_URL"},
+	"RepoBranch":                {"ARO_REPO_BRANCH"},
+	"RepoCommit":                {"ARO_REPO_COMMIT"},
+	"RepoDir":                   {"ARO_REPO_DIR"},
+	"CloneDepth":                {"CLONE_DEPTH"},
+	"ManagementClusterName":     {EnvManagementClusterName},
+	"WorkloadClusterName":       {EnvWorkloadClusterName, "WORKLOAD_CLUSTER_NAMES"},
+	"WorkloadClusterNames":      {"WORKLOAD_CLUSTER_NAMES", EnvWorkloadClusterName},
+	"ClusterNamePrefix":         {EnvClusterNamePrefix},
+	"NamePrefix":                {"NAME_PREFIX"},
+	"OCPVersion":                {EnvOCPVersion},
+	"OCPVersionMP":              {EnvOCPVersionMP},
+	"MachineSKU":                {EnvMachineSKU},
+	"AzureSubscriptionName":     {EnvAzureSubscriptionName},
+	"Environment":               {EnvDeploymentEnv},
+	"CAPIUser":                  {EnvCAPIUser},
+	"WorkloadClusterNamespace":  {EnvWorkloadClusterNamespace},
+	"ResourceGroupName":         {"EXISTING_RESOURCE_GROUP", EnvResourceGroupName},
+	"UseExistingRG":             {"EXISTING_RESOURCE_GROUP", "USE_EXISTING_RG"},
+	"CAPINamespace":             {"USE_K8S", "CAPI_NAMESPACE"},
+	"CAPZNamespace":             {"USE_K8S", "CAPZ_NAMESPACE", "CAPA_NAMESPACE"},
+	"ClusterMode":               {"CLUSTER_MODE"},
+	"UseKubeconfig":             {"USE_KUBECONFIG"},
+	"UseKind":                   {"USE_KIND"},
+	"RecreateOnUnhealthy":       {"RECREATE_ON_UNHEALTHY"},
+	"KindConfigPath":            {"KIND_CONFIG"},
+	"RegistryMirror":            {"REGISTRY_MIRROR"},
+	"MinFreeDiskSpace":          {"MIN_FREE_DISK_SPACE"},
+	"ResultsKeep":               {"RESULTS_KEEP"},
+	"FailOnControllerErrors":    {"FAIL_ON_CONTROLLER_ERRORS"},
+	"ControllerErrorThreshold":  {"CONTROLLER_ERROR_THRESHOLD"},
+	"ControllerErrorAllowlist":  {"CONTROLLER_ERROR_ALLOWLIST"},
+	"HTTPSProxy":                {"COMMAND_HTTPS_PROXY"},
+	"HTTPProxy":                 {"COMMAND_HTTP_PROXY"},
+	"NoProxy":                   {"COMMAND_NO_PROXY"},
+	"ProxyFromEnv":              {"PROXY_FROM_ENV"},
+	"CleanupMode":               {"DRY_RUN", "FORCE"},
+	"ForceDelete":               {"FORCE_DELETE"},
+	"MonitorFormat":             {"MONITOR_FORMAT"},
+	"OutputFormat":              {"OUTPUT_FORMAT"},
+	"SkipConsoleCheck":          {"SKIP_CONSOLE_CHECK"},
+	"CollectMustGather":         {"COLLECT_MUST_GATHER"},
+	"MustGatherTimeout":         {"MUST_GATHER_TIMEOUT"},
+	"ExpectAzureIdentity":       {"EXPECT_AZURE_IDENTITY"},
+	"ClusterctlBinPath":         {"CLUSTERCTL_BIN"},
+	"ClusterctlSHA256":          {"CLUSTERCTL_SHA256"},
+	"ClusterctlMinVersion":      {"CLUSTERCTL_MIN_VERSION"},
+	"ClusterctlVersionStrict":   {"CLUSTERCTL_VERSION_STRICT"},
+	"Strict":                    {"STRICT"},
+	"ScriptsPath":               {"SCRIPTS_PATH"},
+	"GenScriptPath":             {"GEN_SCRIPT_PATH"},
+	"ManifestSchemaLocation":    {"KUBECONFORM_SCHEMA_LOCATION"},
+	"KustomizeDir":              {"KUSTOMIZE_DIR"},
+	"ExistingClusterPolicy":     {"EXISTING_CLUSTER_POLICY"},
+	"ClusterDeploymentTimeout":  {"CLUSTER_DEPLOYMENT_TIMEOUT", "DEPLOYMENT_TIMEOUT"},
+	"ClusterDeletionTimeout":    {"CLUSTER_DELETION_TIMEOUT"},
+	"DeploymentTimeout":         {"CLUSTER_DEPLOYMENT_TIMEOUT", "DEPLOYMENT_TIMEOUT"},
+	"DeploymentStallTimeout":    {"DEPLOYMENT_STALL_TIMEOUT"},
+	"ASOControllerTimeout":      {"ASO_CONTROLLER_TIMEOUT"},
+	"HelmInstallTimeout":        {"HELM_INSTALL_TIMEOUT"},
+	"DeletionPollMaxInterval":   {"DELETION_POLL_MAX_INTERVAL"},
+	"DeletionPollBackoffFactor": {"DELETION_POLL_BACKOFF_FACTOR"},
+	"DeletionDeadlines":         {"DELETION_DEADLINES"},
+	"InfraProviderName":         {"INFRA_PROVIDER"},
+	"MCEAutoEnable":             {"MCE_AUTO_ENABLE"},
+	"MCEEnablementTimeout":      {"MCE_ENABLEMENT_TIMEOUT"},
+	"DeployCharts":              {"DEPLOY_CHARTS"},
+	"RunE2E":                    {"RUN_E2E"},
+	"RedactStrict":              {"REDACT_STRICT"},
+	"OrderedPhases":             {"ORDERED_PHASES"},
+	"E2ETimeout":                {"E2E_TIMEOUT"},
+	"SoakIterations":            {"SOAK_ITERATIONS"},
+	"VerifyParallelism":         {"VERIFY_PARALLELISM"},
+	"ToolPhases":                {"TOOL_PHASES"},
+	"ToolMinVersions":           {"TOOL_MIN_VERSIONS"},
+	"OrphanQueryTimeout":        {"ORPHAN_QUERY_TIMEOUT"},
+	"OrphanMinAge":              {"ORPHAN_MIN_AGE"},
+	"OrphanMatchMode":           {"ORPHAN_MATCH_MODE"},
+	"OrphanIncludeUnknownAge":   {"ORPHAN_INCLUDE_UNKNOWN_AGE"},
+	"DeleteOrphans":             {"DELETE_ORPHANS"},
+}
+
+// sensitiveConfigFieldPattern matches field names whose values must never be printed.
+var sensitiveConfigFieldPattern = regexp.MustCompile(`(?i)secret|password|token|credential`)
+
+// EffectiveConfig returns every TestConfig field in declaration order with its resolved value
+// and source. "default" covers both built-in defaults and values resumed from the deployment
+// state file. Secrets are redacted.
+func (c *TestConfig) EffectiveConfig() []ConfigField {
+	v := reflect.ValueOf(*c)
+	typ := v.Type()
+
+	fields := make([]ConfigField, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		name := typ.Field(i).Name
+		envVars := configFieldEnvVars[name]
+		if name == "Region" {
+			envVars = []string{c.RegionEnvVar}
+		}
+
+		field := ConfigField{Name: name, Value: formatConfigValue(v.Field(i)), Source: "derived"}
+		if len(envVars) > 0 {
+			field.Source = "default"
+			for _, envVar := range envVars {
+				if os.Getenv(envVar) != "" {
+					field.Source = fmt.Sprintf("env (%s)", envVar)
+					break
+				}
+			}
+		}
+
+		sensitive := sensitiveConfigFieldPattern.MatchString(name)
+		for _, envVar := range envVars {
+			sensitive = sensitive || sensitiveEnvKeys[envVar]
+		}
+		if sensitive && field.Value != "" {
+			field.Value = "[REDACTED]"
+		} else {
+			field.Value = redactURLCredentials(field.Value)
+		}
+
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// formatConfigValue renders a TestConfig field value for display. Maps are printed as sorted
+// key=value pairs and providers by name, keeping the output stable and free of nested structs.
+func formatConfigValue(v reflect.Value) string {
+	switch val := v.Interface().(type) {
+	case time.Duration:
+		return val.String()
+	case map[string]string:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		pairs := make([]string, 0, len(keys))
+		for _, k := range keys {
+			pairs = append(pairs, k+"="+val[k])
+		}
+		return strings.Join(pairs, ",")
+	case []string:
+		return strings.Join(val, ",")
+	case []InfraProvider:
+		names := make([]string, 0, len(val))
+		for _, p := range val {
+			names = append(names, p.Name)
+		}
+		return strings.Join(names, ",")
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// FormatEffectiveConfig renders fields as an aligned FIELD / VALUE / SOURCE table.
+func FormatEffectiveConfig(fields []ConfigField) string {
+	nameWidth, valueWidth := len("FIELD"), len("VALUE")
+	for _, f := range fields {
+		nameWidth = max(nameWidth, len(f.Name))
+		valueWidth = max(valueWidth, len(f.Value))
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%-*s  %-*s  %s\n", nameWidth, "FIELD", valueWidth, "VALUE", "SOURCE")
+	for _, f := range fields {
+		value := f.Value
+		if value == "" {
+			value = "-"
+		}
+		fmt.Fprintf(&sb, "%-*s  %-*s  %s\n", nameWidth, f.Name, valueWidth, value, f.Source)
+	}
+	return sb.String()
+}
+
+// WriteEffectiveConfigJSON writes fields to dir/effective-config.json as a JSON object keyed by
+// field name and returns the file path.
+func WriteEffectiveConfigJSON(dir string, fields []ConfigField) (string, error) {
+	obj := make(map[string]ConfigField, len(fields))
+	for _, f := range fields {
+		obj[f.Name] = f
+	}
+
+	data, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal effective config: %w", err)
+	}
+
+	path := filepath.Join(dir, EffectiveConfigFile)
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return path, nil
+}
@@ -25,32 +25,19 @@ func TestDeployment_ProgressDemo(t *testing.T) {
 	fmt.Fprintf(os.Stderr, "Timeout: %v | Poll interval: %v\n\n", timeout, pollInterval)
 	t.Logf("Starting progress demo (timeout: %v)...", timeout)
 
-	iteration := 0
-	for {
-		elapsed := time.Since(startTime)
-		remaining := timeout - elapsed
-
-		if elapsed > timeout {
-			fmt.Fprintf(os.Stderr, "\n❌ Demo timeout reached after %v\n\n", elapsed.Round(time.Second))
-			t.Log("Demo completed - timeout reached")
-			return
-		}
-
-		// Simulate checking control plane (always returns not ready for demo)
-		// In real test, this would be: kubectl get kubeadmcontrolplane ...
-
-		iteration++
-
-		// Report progress using helper function
-		ReportProgress(t, iteration, elapsed, remaining, timeout)
-
+	err := PollUntil(RunContext(), t, timeout, pollInterval, func() (bool, string, error) {
+		// Simulate checking control plane; in a real test this would be: kubectl get ...
 		// For demo, complete after 15 seconds
-		if elapsed > 15*time.Second {
-			fmt.Fprintf(os.Stderr, "\n✅ Demo complete! (took %v)\n\n", elapsed.Round(time.Second))
-			t.Log("Demo completed successfully")
-			return
+		if time.Since(startTime) > 15*time.Second {
+			return true, "", nil
 		}
-
-		time.Sleep(pollInterval)
+		return false, "simulated control plane not ready", nil
+	})
+	if err != nil {
+		t.Logf("Demo completed - %v", err)
+		return
 	}
+
+	fmt.Fprintf(os.Stderr, "\n✅ Demo complete! (took %v)\n\n", time.Since(startTime).Round(time.Second))
+	t.Log("Demo completed successfully")
 }
@@ -0,0 +1,16 @@
+//go:build !windows
+
+package test
+
+import "syscall"
+
+// freeDiskSpace returns the bytes available to unprivileged users on the filesystem containing path.
+func freeDiskSpace(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	// Bavail excludes blocks reserved for root, matching what kind and image pulls can use.
+	// #nosec G115 -- Bsize is a positive block size on every supported platform
+	return stat.Bavail * uint64(stat.Bsize), nil
+}
@@ -0,0 +1,319 @@
+package test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// e2eStep is a single phase test run as part of an end-to-end orchestration test.
+type e2eStep struct {
+	name string
+	run  func(t *testing.T)
+	// timeoutEnv is the environment variable carrying this step's wait-loop timeout.
+	// It is set to the time remaining before the overall deadline so that wait loops
+	// in the reused phase tests never outlive the orchestration test.
+	timeoutEnv string
+	// allowSkip treats a skipped step as success (e.g., deleting an already-deleted cluster).
+	allowSkip bool
+}
+
+// runE2ESteps runs steps in order as subtests under a single overall deadline.
+// It stops at the first step that fails or skips (a skip means a prerequisite is missing)
+// and returns the accumulated results along with whether all steps passed.
+func runE2ESteps(t *testing.T, steps []e2eStep, deadline time.Time) ([]E2EStepResult, bool) {
+	t.Helper()
+
+	var results []E2EStepResult
+	for _, step := range steps {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			PrintToTTY("❌ Overall deadline reached before step %s\n", step.name)
+			t.Errorf("Overall E2E deadline reached before step %s", step.name)
+			return results, false
+		}
+		if step.timeoutEnv != "" {
+			t.Setenv(step.timeoutEnv, remaining.Round(time.Second).String())
+		}
+
+		PrintToTTY("\n🔄 E2E step: %s (remaining budget: %v)\n", step.name, remaining.Round(time.Second))
+		start := time.Now()
+		var skipped bool
+		passed := t.Run(step.name, func(t *testing.T) {
+			defer func() { skipped = t.Skipped() }()
+			step.run(t)
+		})
+
+		result := E2EStepResult{Name: step.name, Status: E2EStepPassed, Duration: time.Since(start)}
+		switch {
+		case !passed:
+			result.Status = E2EStepFailed
+		case skipped:
+			result.Status = E2EStepSkipped
+		}
+		results = append(results, result)
+
+		if result.Status == E2EStepFailed || (result.Status == E2EStepSkipped && !step.allowSkip) {
+			PrintToTTY("❌ E2E step %s %s — aborting\n", step.name, result.Status)
+			t.Errorf("E2E step %s %s", step.name, result.Status)
+			return results, false
+		}
+	}
+	return results, true
+}
+
+// orderedPhaseSteps maps each phase file to the function listing its tests in run order.
+// TestOrderedPhaseSteps checks every test the file declares is listed exactly once.
+var orderedPhaseSteps = map[string]func() []e2eStep{
+	"01_check_dependencies_test.go": checkDependenciesPhaseSteps,
+	"02_setup_test.go":              setupPhaseSteps,
+	"03_cluster_test.go":            clusterPhaseSteps,
+	"04_generate_yamls_test.go":     generateYAMLsPhaseSteps,
+	"05_deploy_crs_test.go":         deployPhaseSteps,
+	"06_verification_test.go":       verificationPhaseSteps,
+	"07_deletion_test.go":           deletionPhaseSteps,
+	"08_cleanup_test.go":            cleanupPhaseSteps,
+	"09_teardown_test.go":           teardownPhaseSteps,
+}
+
+// runOrderedPhase runs a phase file's steps as subtests in the listed order, so the sequence
+// holds under -shuffle and does not depend on where each test is declared. Unlike
+// runE2ESteps it keeps going after a failure, as the top-level tests do (-failfast still
+// applies). Gated by ORDERED_PHASES=true; select only the runners so the steps do not also
+// run top-level:
+//
+//	ORDERED_PHASES=true go test ./test -count=1 -v -run '^TestSetup_ZZZ_RunPhase$' -shuffle on
+func runOrderedPhase(t *testing.T, steps []e2eStep) {
+	t.Helper()
+
+	if !NewTestConfig().OrderedPhases {
+		t.Skip("ORDERED_PHASES is not set to true, skipping ordered phase runner")
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, step.run)
+	}
+}
+
+// e2eDeploySteps returns the phase tests that create and verify a workload cluster:
+// generate → apply → wait-for-control-plane → retrieve-kubeconfig → verify-nodes.
+func e2eDeploySteps() []e2eStep {
+	return []e2eStep{
+		{name: "GenerateResources", run: TestInfrastructure_GenerateResources},
+		{name: "CreateNamespace", run: TestDeployment_00_CreateNamespace},
+		{name: "ApplyClusterYAMLs", run: TestDeployment_ApplyClusterYAMLs},
+		{name: "WaitForControlPlane", run: TestDeployment_WaitForControlPlane, timeoutEnv: "CLUSTER_DEPLOYMENT_TIMEOUT"},
+		{name: "RetrieveKubeconfig", run: TestVerification_RetrieveKubeconfig},
+		{name: "ClusterNodes", run: TestVerification_ClusterNodes},
+	}
+}
+
+// e2eDeleteSteps returns the phase tests that delete the workload cluster and wait for it
+// to be gone. Deleting a cluster that does not exist is skipped, not failed.
+func e2eDeleteSteps() []e2eStep {
+	return []e2eStep{
+		{name: "DeleteCluster", run: TestDeletion_DeleteCluster, allowSkip: true},
+		{name: "WaitForClusterDeletion", run: TestDeletion_WaitForClusterDeletion, timeoutEnv: "CLUSTER_DELETION_TIMEOUT"},
+	}
+}
+
+// TestE2E_DeployAndVerify deploys a workload cluster and verifies it in a single test:
+// generate → apply → wait-for-control-plane → retrieve-kubeconfig → verify-nodes.
+// It reuses the existing phase tests under one overall deadline (E2E_TIMEOUT) and bails out
+// with the accumulated state on the first hard failure. Gated by RUN_E2E=1.
+//
+// Requires a management cluster with controllers already deployed (phases 01-03).
+// Run with a go test timeout larger than E2E_TIMEOUT, e.g.:
+//
+//	RUN_E2E=1 go test ./test -count=1 -v -run TestE2E_DeployAndVerify -timeout 2h
+func TestE2E_DeployAndVerify(t *testing.T) {
+	config := NewTestConfig()
+	if !config.RunE2E {
+		t.Skip("RUN_E2E is not set to 1, skipping end-to-end deploy orchestration")
+	}
+
+	PrintTestHeader(t, "TestE2E_DeployAndVerify",
+		"Generate, apply, wait for control plane, retrieve kubeconfig, and verify nodes")
+
+	deadline := time.Now().Add(config.E2ETimeout)
+	PrintToTTY("Overall deadline: %v (E2E_TIMEOUT)\n", config.E2ETimeout)
+	t.Logf("Running deploy orchestration with overall deadline %v", config.E2ETimeout)
+
+	steps := e2eDeploySteps()
+	results, ok := runE2ESteps(t, steps, deadline)
+
+	// Accumulate the last observed cluster state for the final report
+	var state []string
+	kubeContext := config.GetKubeContext()
+	if data, err := MonitorCluster(t, kubeContext, config.WorkloadClusterNamespace, config.GetProvisionedClusterName()); err == nil {
+		state = append(state, data.FormatSummary())
+	} else {
+		state = append(state, "Cluster status unavailable: "+err.Error())
+	}
+
+	summary := FormatE2ESummary("E2E Deploy Summary", results, state)
+	PrintToTTY("%s\n", summary)
+	t.Log(summary)
+
+	if !ok {
+		t.Fatalf("End-to-end deploy failed after %d/%d steps", len(results), len(steps))
+	}
+}
+
+// TestE2E_TeardownAndVerify deletes the workload cluster and verifies that everything is gone
+// in a single test: delete → wait for deletion → verify control plane and machine pools are
+// gone → confirm the Azure resource group is deleted (ARO only). The final deletion state is
+// aggregated into one report. Gated by RUN_E2E=1, with an overall deadline of E2E_TIMEOUT.
+//
+//	RUN_E2E=1 go test ./test -count=1 -v -run TestE2E_TeardownAndVerify -timeout 2h
+func TestE2E_TeardownAndVerify(t *testing.T) {
+	config := NewTestConfig()
+	if !config.RunE2E {
+		t.Skip("RUN_E2E is not set to 1, skipping end-to-end teardown orchestration")
+	}
+
+	// Set KUBECONFIG for external cluster mode
+	if config.IsExternalCluster() {
+		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
+	}
+
+	PrintTestHeader(t, "TestE2E_TeardownAndVerify",
+		"Delete the workload cluster and verify all resources are removed")
+
+	deadline := time.Now().Add(config.E2ETimeout)
+	PrintToTTY("Overall deadline: %v (E2E_TIMEOUT)\n", config.E2ETimeout)
+	t.Logf("Running teardown orchestration with overall deadline %v", config.E2ETimeout)
+
+	kubeContext := config.GetKubeContext()
+	clusterName := config.GetProvisionedClusterName()
+	resourceGroup := ""
+	if config.HasProvider("aro") {
+		resourceGroup = config.ResourceGroupName
+	}
+
+	var lastStatus DeletionResourceStatus
+	steps := append(e2eDeleteSteps(), e2eStep{
+		name: "VerifyCAPIResourcesDeleted", run: func(t *testing.T) {
+			lastStatus = GetDeletionResourceStatus(t, ExecKubeClient{}, kubeContext, config.WorkloadClusterNamespace, clusterName, "")
+			// ASO resources outlive the CAPI objects; VerifyResourceGroupDeleted waits for them
+			capiStatus := lastStatus
+			capiStatus.ASOResourcesRemaining = 0
+			if remaining := RemainingDeletionResources(capiStatus); len(remaining) > 0 {
+				t.Fatalf("Resources still exist after cluster deletion: %s", strings.Join(remaining, ", "))
+			}
+			PrintToTTY("✅ Cluster, control plane, and machine pools are deleted\n")
+		}})
+	if resourceGroup != "" {
+		steps = append(steps, e2eStep{name: "VerifyResourceGroupDeleted", run: func(t *testing.T) {
+			// The resource group and ASO resources are removed asynchronously after the cluster,
+			// so poll until the deadline
+			pollInterval := 30 * time.Second
+			iteration := 0
+			for {
+				iteration++
+				lastStatus = GetDeletionResourceStatus(t, ExecKubeClient{}, kubeContext, config.WorkloadClusterNamespace, clusterName, resourceGroup)
+				remaining := RemainingDeletionResources(lastStatus)
+				if len(remaining) == 0 {
+					PrintToTTY("✅ Azure resource group '%s' and ASO resources have been deleted\n", resourceGroup)
+					return
+				}
+				if time.Until(deadline) < pollInterval {
+					t.Fatalf("Resources still exist at the overall deadline: %s\n\n"+
+						"To clean up manually: make clean-azure", strings.Join(remaining, ", "))
+				}
+				PrintToTTY("[%d] ⏳ Waiting for: %s\n", iteration, strings.Join(remaining, ", "))
+				time.Sleep(pollInterval)
+			}
+		}})
+	}
+
+	results, ok := runE2ESteps(t, steps, deadline)
+
+	// Aggregate the final deletion state into the report
+	lastStatus = GetDeletionResourceStatus(t, ExecKubeClient{}, kubeContext, config.WorkloadClusterNamespace, clusterName, resourceGroup)
+	state := strings.Split(strings.TrimRight(FormatDeletionProgress(lastStatus), "\n"), "\n")
+
+	summary := FormatE2ESummary("E2E Teardown Summary", results, state)
+	PrintToTTY("%s\n", summary)
+	t.Log(summary)
+
+	if !ok {
+		t.Fatalf("End-to-end teardown failed after %d/%d steps", len(results), len(steps))
+	}
+	if remaining := RemainingDeletionResources(lastStatus); len(remaining) > 0 {
+		t.Errorf("Resources still exist after teardown: %s", strings.Join(remaining, ", "))
+	}
+}
+
+// TestE2E_SoakLoop runs the full create → verify → delete lifecycle SOAK_ITERATIONS times for
+// reliability testing, recording each iteration's create and delete durations and the first
+// failing step, then reports the flake rate. Every iteration gets its own E2E_TIMEOUT deadline
+// for creation and another for deletion. Deletion runs even when creation failed, so each
+// iteration starts from a clean namespace; if deletion itself fails the loop stops, since the
+// next iteration would collide with the leftover cluster.
+//
+// Requires a management cluster with controllers already deployed (phases 01-03):
+//
+//	SOAK_ITERATIONS=5 go test ./test -count=1 -v -run TestE2E_SoakLoop -timeout 0
+func TestE2E_SoakLoop(t *testing.T) {
+	config := NewTestConfig()
+	if config.SoakIterations == 0 {
+		t.Skip("SOAK_ITERATIONS is not set, skipping soak loop")
+	}
+
+	// Set KUBECONFIG for external cluster mode
+	if config.IsExternalCluster() {
+		SetEnvVar(t, "KUBECONFIG", config.UseKubeconfig)
+	}
+
+	PrintTestHeader(t, "TestE2E_SoakLoop",
+		fmt.Sprintf("Create, verify, and delete the workload cluster %d times", config.SoakIterations))
+	PrintToTTY("Per-iteration deadline: %v for creation, %v for deletion (E2E_TIMEOUT)\n", config.E2ETimeout, config.E2ETimeout)
+
+	var results []SoakIterationResult
+	for i := 1; i <= config.SoakIterations; i++ {
+		result := SoakIterationResult{Iteration: i}
+
+		t.Run(fmt.Sprintf("Iteration%02d", i), func(t *testing.T) {
+			PrintToTTY("\n🔁 Soak iteration %d/%d\n", i, config.SoakIterations)
+
+			start := time.Now()
+			createResults, _ := runE2ESteps(t, e2eDeploySteps(), time.Now().Add(config.E2ETimeout))
+			result.CreateDuration = time.Since(start)
+			result.Failure = firstFailedE2EStep(createResults)
+
+			// Always clean up, so a failed iteration does not leak into the next one
+			start = time.Now()
+			deleteResults, deleted := runE2ESteps(t, e2eDeleteSteps(), time.Now().Add(config.E2ETimeout))
+			result.DeleteDuration = time.Since(start)
+			result.CleanupFailed = !deleted
+			if result.Failure == "" {
+				result.Failure = firstFailedE2EStep(deleteResults)
+			}
+		})
+
+		results = append(results, result)
+		icon := "✅"
+		if result.Failure != "" {
+			icon = "❌"
+		}
+		PrintToTTY("%s Iteration %d: create %v, delete %v\n", icon,
+			i, result.CreateDuration.Round(time.Second), result.DeleteDuration.Round(time.Second))
+
+		if result.CleanupFailed {
+			PrintToTTY("❌ Cleanup failed in iteration %d — stopping the soak loop\n", i)
+			t.Errorf("Cleanup failed in iteration %d; stopped after %d/%d iterations. Clean up manually before re-running.",
+				i, i, config.SoakIterations)
+			break
+		}
+		if RunContext().Err() != nil {
+			t.Errorf("Interrupted after %d/%d iterations", i, config.SoakIterations)
+			break
+		}
+	}
+
+	summary := FormatSoakSummary(results, config.SoakIterations)
+	PrintToTTY("%s\n", summary)
+	t.Log(summary)
+}
@@ -0,0 +1,81 @@
+package test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"testing"
+)
+
+// TestMain installs a run context that is cancelled on the first interrupt, so Ctrl-C stops
+// PollUntil wait loops and kills in-flight az/kubectl calls instead of leaving the run blocked
+// while Azure keeps provisioning. A second interrupt gets Go's default handling and exits.
+func TestMain(m *testing.M) {
+	if phase := os.Getenv("PHASE"); phase != "" {
+		if err := applyPhaseSelector(phase, os.Getenv("ORDERED_PHASES") == "true"); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	SetRunContext(ctx)
+
+	if keep := parseResultsKeep(); keep > 0 {
+		removed, err := PruneResults(keep)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to prune old results directories: %v\n", err)
+		}
+		if len(removed) > 0 {
+			fmt.Fprintf(os.Stderr, "Pruned %d old results director(ies), keeping the newest %d\n", len(removed), keep)
+		}
+	}
+
+	finished := make(chan struct{})
+	go func() {
+		select {
+		case <-finished:
+			return
+		case <-ctx.Done():
+		}
+		select {
+		case <-finished:
+			return
+		default:
+		}
+		PrintToTTY("\n⚠️  Interrupt received — stopping wait loops and in-flight commands (press Ctrl-C again to force quit)\n")
+		stop()
+	}()
+
+	code := m.Run()
+	close(finished)
+	stop()
+	os.Exit(code)
+}
+
+// applyPhaseSelector restricts the run to the tests of one phase (PHASE) by setting the -run
+// filter from PhaseSelectors. An explicit -run takes precedence and is left alone.
+func applyPhaseSelector(phase string, ordered bool) error {
+	pattern, err := PhaseRunPattern(phase, ordered)
+	if err != nil {
+		return err
+	}
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+	run := flag.Lookup("test.run")
+	if run == nil {
+		return fmt.Errorf("PHASE=%s: go test -run flag is not registered", phase)
+	}
+	if current := run.Value.String(); current != "" {
+		fmt.Fprintf(os.Stderr, "Warning: -run %q takes precedence over PHASE=%s\n", current, phase)
+		return nil
+	}
+	if err := run.Value.Set(pattern); err != nil {
+		return fmt.Errorf("PHASE=%s: failed to set -run %q: %w", phase, pattern, err)
+	}
+	fmt.Fprintf(os.Stderr, "PHASE=%s: running tests matching %s\n", phase, pattern)
+	return nil
+}
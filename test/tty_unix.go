@@ -0,0 +1,13 @@
+//go:build !windows
+
+package test
+
+import "os"
+
+// ttyDevice is the terminal device written to by openTTY on Unix-like systems.
+const ttyDevice = "/dev/tty"
+
+// openTTYDevice opens the controlling terminal for direct, unbuffered writes.
+func openTTYDevice() (*os.File, error) {
+	return os.OpenFile(ttyDevice, os.O_WRONLY, 0)
+}
@@ -0,0 +1,14 @@
+//go:build windows
+
+package test
+
+import "os"
+
+// ttyDevice is the console output device written to by openTTY on Windows.
+// CONOUT$ refers to the active console screen buffer even when stdout/stderr are redirected.
+const ttyDevice = "CONOUT$"
+
+// openTTYDevice opens the console for direct, unbuffered writes.
+func openTTYDevice() (*os.File, error) {
+	return os.OpenFile(ttyDevice, os.O_WRONLY, 0)
+}